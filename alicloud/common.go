@@ -20,6 +20,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/google/uuid"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
 type InstanceNetWork string
@@ -102,6 +103,13 @@ const (
 
 	PUBLISHED   = Status("Published")
 	NOPUBLISHED = Status("NonPublished")
+
+	SnapshotProgressing  = Status("progressing")
+	SnapshotAccomplished = Status("accomplished")
+	SnapshotFailed       = Status("failed")
+
+	ImageCreateFailed = Status("CreateFailed")
+	ImageUnAvailable  = Status("UnAvailable")
 )
 
 type IPType string
@@ -255,6 +263,13 @@ func StringPointer(s string) *string {
 	return &s
 }
 
+func StringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 func BoolPointer(b bool) *bool {
 	return &b
 }
@@ -281,6 +296,17 @@ const (
 	TagResourceDisk          = TagResourceType("disk")
 	TagResourceSecurityGroup = TagResourceType("securitygroup")
 	TagResourceEni           = TagResourceType("eni")
+
+	TagResourceVpc        = TagResourceType("VPC")
+	TagResourceVSwitch    = TagResourceType("VSWITCH")
+	TagResourceEip        = TagResourceType("EIP")
+	TagResourceNatGateway = TagResourceType("NATGATEWAY")
+
+	TagResourceLoadBalancer    = TagResourceType("loadbalancer")
+	TagResourceDBInstance      = TagResourceType("dbinstance")
+	TagResourceKVStoreInstance = TagResourceType("INSTANCE")
+	TagResourceBucket          = TagResourceType("bucket")
+	TagResourceOtsInstance     = TagResourceType("otsinstance")
 )
 
 func getPagination(pageNumber, pageSize int) (pagination common.Pagination) {
@@ -361,10 +387,15 @@ func writeToFile(filePath string, data interface{}) error {
 	return nil
 }
 
+// Invoker retries a function on the errors of its catchers. The throttling and unavailable service errors are already
+// retried by the client of the provider, and the invoker retries the client failures, like the network failures and
+// the timeouts, besides the errors specific to a resource.
 type Invoker struct {
 	catchers []*Catcher
 }
 
+// Catcher retries the errors which contain the Reason at most RetryCount-1 times. The delay between the retries
+// grows exponentially from RetryWaitSeconds with full jitter, and the retries stop after RetryCount*RetryWaitSeconds.
 type Catcher struct {
 	Reason           string
 	RetryCount       int
@@ -372,14 +403,12 @@ type Catcher struct {
 }
 
 var ClientErrorCatcher = Catcher{AliyunGoClientFailure, 10, 5}
-var ServiceBusyCatcher = Catcher{"ServiceUnavailable", 10, 5}
-var ThrottlingCatcher = Catcher{Throttling, 10, 10}
+var ServerUnreachableCatcher = Catcher{ServerUnreachable, 10, 5}
 
 func NewInvoker() Invoker {
 	i := Invoker{}
 	i.AddCatcher(ClientErrorCatcher)
-	i.AddCatcher(ServiceBusyCatcher)
-	i.AddCatcher(ThrottlingCatcher)
+	i.AddCatcher(ServerUnreachableCatcher)
 	return i
 }
 
@@ -388,25 +417,48 @@ func (a *Invoker) AddCatcher(catcher Catcher) {
 }
 
 func (a *Invoker) Run(f func() error) error {
-	err := f()
+	policies := make(map[*Catcher]*connectivity.RetryPolicy)
+	attempts := make(map[*Catcher]int)
+	deadlines := make(map[*Catcher]time.Time)
+
+	for {
+		err := f()
+		if err == nil {
+			return nil
+		}
 
-	if err == nil {
-		return nil
-	}
+		var catcher *Catcher
+		for _, c := range a.catchers {
+			if IsExceptedErrors(err, []string{c.Reason}) {
+				catcher = c
+				break
+			}
+		}
+		if catcher == nil {
+			return err
+		}
 
-	for _, catcher := range a.catchers {
-		if IsExceptedErrors(err, []string{catcher.Reason}) {
-			catcher.RetryCount--
+		policy, ok := policies[catcher]
+		if !ok {
+			wait := time.Duration(catcher.RetryWaitSeconds) * time.Second
+			policy = connectivity.NewRetryPolicy(catcher.RetryCount, wait, 4*wait, 0)
+			policies[catcher] = policy
+			deadlines[catcher] = time.Now().Add(time.Duration(catcher.RetryCount) * wait)
+		}
 
-			if catcher.RetryCount <= 0 {
-				return fmt.Errorf("Retry timeout and got an error: %#v.", err)
-			} else {
-				time.Sleep(time.Duration(catcher.RetryWaitSeconds) * time.Second)
-				return a.Run(f)
-			}
+		attempts[catcher]++
+		remaining := time.Until(deadlines[catcher])
+		if attempts[catcher] >= catcher.RetryCount || remaining <= 0 {
+			log.Printf("[WARN] Giving up retrying after %d attempts, since it got an error: %s", attempts[catcher], err)
+			return err
+		}
+
+		delay := policy.Backoff(attempts[catcher] - 1)
+		if delay > remaining {
+			delay = remaining
 		}
+		time.Sleep(delay)
 	}
-	return err
 }
 
 func buildClientToken(prefix string) string {
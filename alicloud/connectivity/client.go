@@ -49,43 +49,45 @@ import (
 )
 
 // AliyunClient of aliyun
+//
+// Each service client is built lazily at its first use and cached behind its own lock, so that building one
+// service client never blocks the API calls of the others, and the API calls themselves run concurrently.
 type AliyunClient struct {
 	Region   Region
 	RegionId string
 	//In order to build ots table client, add accesskey and secretkey in aliyunclient temporarily.
-	AccessKey                    string
-	SecretKey                    string
-	SecurityToken                string
-	OtsInstanceName              string
-	accountIdMutex               sync.RWMutex
-	config                       *Config
-	accountId                    string
-	ecsconn                      *ecs.Client
-	essconn                      *ess.Client
-	rdsconn                      *rds.Client
-	vpcconn                      *vpc.Client
-	slbconn                      *slb.Client
-	ossconn                      *oss.Client
-	dnsconn                      *dns.Client
-	ramconn                      ram.RamClientInterface
-	csconn                       *cs.Client
-	cdnconn                      *cdn.CdnClient
-	kmsconn                      *kms.Client
-	otsconn                      *ots.Client
-	cmsconn                      *cms.Client
-	logconn                      *sls.Client
-	fcconn                       *fc.Client
-	cenconn                      *cbn.Client
-	pvtzconn                     *pvtz.Client
-	ddsconn                      *dds.Client
-	stsconn                      *sts.Client
-	rkvconn                      *r_kvstore.Client
-	dhconn                       *datahub.DataHub
-	mnsconn                      *ali_mns.MNSClient
-	cloudapiconn                 *cloudapi.Client
-	tablestoreconnByInstanceName map[string]*tablestore.TableStoreClient
-	csprojectconnByKey           map[string]*cs.ProjectClient
-	drdsconn                     *drds.Client
+	// They keep the credential the client is built with, and Credential returns the renewed one.
+	AccessKey       string
+	SecretKey       string
+	SecurityToken   string
+	OtsInstanceName string
+	DefaultTags     map[string]string
+	accountIdMutex  sync.RWMutex
+	accountId       string
+
+	// configMutex guards config and sourceConfig, which are replaced when the temporary credential is renewed.
+	configMutex sync.RWMutex
+	config      *Config
+	// sourceConfig keeps the credential resolved from the provider configuration, which is used to assume the RAM role again.
+	sourceConfig         *Config
+	credentialMutex      sync.Mutex
+	credentialExpiration time.Time
+
+	connsMutex sync.Mutex
+	conns      map[string]*serviceConn
+
+	retryPolicy *RetryPolicy
+	apiTracer   ApiTracer
+
+	rateLimitersMutex sync.Mutex
+	rateLimiters      map[ServiceCode]*RateLimiter
+}
+
+// serviceConn caches the client of one service together with the configuration it was built with.
+type serviceConn struct {
+	sync.Mutex
+	config *Config
+	conn   interface{}
 }
 
 type ApiVersion string
@@ -94,6 +96,8 @@ const (
 	ApiVersion20140526 = ApiVersion("2014-05-26")
 	ApiVersion20160815 = ApiVersion("2016-08-15")
 	ApiVersion20140515 = ApiVersion("2014-05-15")
+	ApiVersion20160428 = ApiVersion("2016-04-28")
+	ApiVersion20150101 = ApiVersion("2015-01-01")
 )
 
 const businessInfoKey = "Terraform"
@@ -102,7 +106,35 @@ const DefaultClientRetryCountSmall = 5
 const DefaultClientRetryCountMedium = 10
 const DefaultClientRetryCountLarge = 15
 
-var goSdkMutex = sync.RWMutex{} // The Go SDK is not thread-safe
+const DefaultRoleSessionName = "terraform"
+const DefaultRoleSessionExpiration = 3600
+
+// The temporary credential of an ECS RAM role or an assumed role will be renewed when it is going to expire in this window.
+const CredentialRenewWindow = 5 * time.Minute
+
+// The endpoint mapping of the official Go SDK is a global map without any lock, so it is only written
+// when a client is being configured, before any API call is made.
+var endpointMappingMutex = sync.Mutex{}
+
+var loadTZDataOnce = sync.Once{}
+
+// sdkEndpointMappingProducts maps the service codes to the products of the official Go SDK whose endpoints can be overridden.
+var sdkEndpointMappingProducts = map[ServiceCode]string{
+	ECSCode:      string(ECSCode),
+	RDSCode:      string(RDSCode),
+	SLBCode:      string(SLBCode),
+	VPCCode:      string(VPCCode),
+	CENCode:      string(CENCode),
+	ESSCode:      string(ESSCode),
+	CMSCode:      string(CMSCode),
+	DRDSCode:     string(DRDSCode),
+	OTSCode:      string(OTSCode),
+	PVTZCode:     string(PVTZCode),
+	STSCode:      string(STSCode),
+	DDSCode:      string(DDSCode),
+	KVSTORECode:  fmt.Sprintf("R-%s", string(KVSTORECode)),
+	CLOUDAPICode: fmt.Sprintf("R-%s", string(CLOUDAPICode)),
+}
 
 // Client for AliyunClient
 func (c *Config) Client() (*AliyunClient, error) {
@@ -111,31 +143,112 @@ func (c *Config) Client() (*AliyunClient, error) {
 		return nil, err
 	}
 
-	return &AliyunClient{
-		config:                       c,
-		Region:                       c.Region,
-		RegionId:                     c.RegionId,
-		AccessKey:                    c.AccessKey,
-		SecretKey:                    c.SecretKey,
-		SecurityToken:                c.SecurityToken,
-		OtsInstanceName:              c.OtsInstanceName,
-		accountId:                    c.AccountId,
-		tablestoreconnByInstanceName: make(map[string]*tablestore.TableStoreClient),
-		csprojectconnByKey:           make(map[string]*cs.ProjectClient),
-	}, nil
+	tracer := c.ApiTracer
+	if tracer == nil && c.ApiTraceFile != "" {
+		if tracer, err = NewFileApiTracer(c.ApiTraceFile); err != nil {
+			return nil, err
+		}
+	}
+	if tracer == nil {
+		tracer = logApiTracer{}
+	}
+
+	client := &AliyunClient{
+		config:          c,
+		sourceConfig:    c,
+		Region:          c.Region,
+		RegionId:        c.RegionId,
+		OtsInstanceName: c.OtsInstanceName,
+		DefaultTags:     c.DefaultTags,
+		accountId:       c.AccountId,
+		conns:           make(map[string]*serviceConn),
+		retryPolicy:     NewRetryPolicy(c.MaxRetries, c.RetryBaseDelay, c.RetryMaxDelay, DefaultRetryMaxElapsed),
+		apiTracer:       tracer,
+		rateLimiters:    make(map[ServiceCode]*RateLimiter),
+	}
+	client.loadEndpointMappings()
+
+	if c.EcsRoleName != "" || c.RamRoleArn != "" {
+		if err := client.renewCredential(); err != nil {
+			return nil, err
+		}
+	}
+	client.AccessKey, client.SecretKey, client.SecurityToken = client.Credential()
+
+	return client, nil
 }
 
-func (client *AliyunClient) WithEcsClient(do func(*ecs.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
+// loadEndpointMappings registers the self-defined endpoints of the official Go SDK products.
+func (client *AliyunClient) loadEndpointMappings() {
+	endpointMappingMutex.Lock()
+	defer endpointMappingMutex.Unlock()
 
-	// Initialize the ECS client if necessary
-	if client.ecsconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, ECSCode)
+	for serviceCode, product := range sdkEndpointMappingProducts {
+		endpoint := client.getConfig().loadEndpoint(serviceCode)
+		if endpoint == "" && serviceCode == PVTZCode {
+			endpoint = "pvtz.aliyuncs.com"
+		}
 		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(ECSCode), endpoint)
+			endpoints.AddEndpointMapping(client.RegionId, product, endpoint)
 		}
-		ecsconn, err := ecs.NewClientWithOptions(client.config.RegionId, client.getSdkConfig().WithTimeout(time.Duration(60)*time.Second), client.config.getAuthCredential(true))
+	}
+}
+
+// getConn returns the cached client of a service, and it builds the client at the first use or after the credential is renewed.
+func (client *AliyunClient) getConn(key string, build func(*Config) (interface{}, error)) (interface{}, error) {
+	if err := client.renewCredentialIfExpired(); err != nil {
+		return nil, err
+	}
+	return client.getConnWithConfig(key, client.getConfig(), build)
+}
+
+func (client *AliyunClient) getConnWithConfig(key string, config *Config, build func(*Config) (interface{}, error)) (interface{}, error) {
+	client.connsMutex.Lock()
+	conn, ok := client.conns[key]
+	if !ok {
+		conn = &serviceConn{}
+		client.conns[key] = conn
+	}
+	client.connsMutex.Unlock()
+
+	conn.Lock()
+	defer conn.Unlock()
+
+	if conn.conn == nil || conn.config != config {
+		c, err := client.retry(func() (interface{}, error) {
+			return build(config)
+		})
+		if err != nil {
+			return nil, err
+		}
+		conn.conn = c
+		conn.config = config
+	}
+	return conn.conn, nil
+}
+
+func (client *AliyunClient) getConfig() *Config {
+	client.configMutex.RLock()
+	defer client.configMutex.RUnlock()
+	return client.config
+}
+
+// Credential returns the current credential of the client, which is renewed when it is a temporary one.
+func (client *AliyunClient) Credential() (accessKey, secretKey, securityToken string) {
+	config := client.getConfig()
+	return config.AccessKey, config.SecretKey, config.SecurityToken
+}
+
+func (client *AliyunClient) getSourceConfig() *Config {
+	client.configMutex.RLock()
+	defer client.configMutex.RUnlock()
+	return client.sourceConfig
+}
+
+func (client *AliyunClient) WithEcsClient(do func(*ecs.Client) (interface{}, error)) (interface{}, error) {
+	// Initialize the ECS client if necessary
+	conn, err := client.getConn(string(ECSCode), func(config *Config) (interface{}, error) {
+		ecsconn, err := ecs.NewClientWithOptions(config.RegionId, client.getSdkConfig().WithTimeout(time.Duration(60)*time.Second), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the ECS client: %#v", err)
 		}
@@ -143,125 +256,112 @@ func (client *AliyunClient) WithEcsClient(do func(*ecs.Client) (interface{}, err
 		if _, err := ecsconn.DescribeRegions(ecs.CreateDescribeRegionsRequest()); err != nil {
 			return nil, err
 		}
-		client.ecsconn = ecsconn
+		return ecsconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.ecsconn)
+	return client.invoke(ECSCode, func() (interface{}, error) {
+		return do(conn.(*ecs.Client))
+	})
 }
 
 func (client *AliyunClient) WithRdsClient(do func(*rds.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the RDS client if necessary
-	if client.rdsconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, RDSCode)
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(RDSCode), endpoint)
-		}
-		rdsconn, err := rds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	conn, err := client.getConn(string(RDSCode), func(config *Config) (interface{}, error) {
+		rdsconn, err := rds.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the RDS client: %#v", err)
 		}
-
-		client.rdsconn = rdsconn
+		return rdsconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.rdsconn)
+	return client.invoke(RDSCode, func() (interface{}, error) {
+		return do(conn.(*rds.Client))
+	})
 }
 
 func (client *AliyunClient) WithSlbClient(do func(*slb.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the SLB client if necessary
-	if client.slbconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, SLBCode)
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(SLBCode), endpoint)
-		}
-		slbconn, err := slb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	conn, err := client.getConn(string(SLBCode), func(config *Config) (interface{}, error) {
+		slbconn, err := slb.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the SLB client: %#v", err)
 		}
-
-		client.slbconn = slbconn
+		return slbconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.slbconn)
+	return client.invoke(SLBCode, func() (interface{}, error) {
+		return do(conn.(*slb.Client))
+	})
 }
 
 func (client *AliyunClient) WithVpcClient(do func(*vpc.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the VPC client if necessary
-	if client.vpcconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, VPCCode)
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(VPCCode), endpoint)
-		}
-		vpcconn, err := vpc.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	conn, err := client.getConn(string(VPCCode), func(config *Config) (interface{}, error) {
+		vpcconn, err := vpc.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the VPC client: %#v", err)
 		}
-
-		client.vpcconn = vpcconn
+		return vpcconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.vpcconn)
+	return client.invoke(VPCCode, func() (interface{}, error) {
+		return do(conn.(*vpc.Client))
+	})
 }
 
 func (client *AliyunClient) WithCenClient(do func(*cbn.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the CEN client if necessary
-	if client.cenconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, CENCode)
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(CENCode), endpoint)
-		}
-		cenconn, err := cbn.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	conn, err := client.getConn(string(CENCode), func(config *Config) (interface{}, error) {
+		cenconn, err := cbn.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the CEN client: %#v", err)
 		}
-
-		client.cenconn = cenconn
+		return cenconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.cenconn)
+	return client.invoke(CENCode, func() (interface{}, error) {
+		return do(conn.(*cbn.Client))
+	})
 }
 
 func (client *AliyunClient) WithEssClient(do func(*ess.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the ESS client if necessary
-	if client.essconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, ESSCode)
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(ESSCode), endpoint)
-		}
-		essconn, err := ess.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	conn, err := client.getConn(string(ESSCode), func(config *Config) (interface{}, error) {
+		essconn, err := ess.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the ESS client: %#v", err)
 		}
-
-		client.essconn = essconn
+		return essconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.essconn)
+	return client.invoke(ESSCode, func() (interface{}, error) {
+		return do(conn.(*ess.Client))
+	})
 }
 
 func (client *AliyunClient) WithOssClient(do func(*oss.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the OSS client if necessary
-	if client.ossconn == nil {
+	conn, err := client.getConn(string(OSSCode), func(config *Config) (interface{}, error) {
 		schma := "https"
-		endpoint := loadEndpoint(client.config.RegionId, OSSCode)
+		endpoint := config.loadEndpoint(OSSCode)
 		if endpoint == "" {
 			endpointItem, _ := client.describeEndpointForService(strings.ToLower(string(OSSCode)))
 			if endpointItem != nil && len(endpointItem.Endpoint) > 0 {
@@ -277,7 +377,7 @@ func (client *AliyunClient) WithOssClient(do func(*oss.Client) (interface{}, err
 				}
 				endpoint = endpointItem.Endpoint
 			} else {
-				endpoint = fmt.Sprintf("oss-%s.aliyuncs.com", client.RegionId)
+				endpoint = fmt.Sprintf("oss-%s.aliyuncs.com", config.RegionId)
 			}
 		}
 		if !strings.HasPrefix(endpoint, "http") {
@@ -286,26 +386,30 @@ func (client *AliyunClient) WithOssClient(do func(*oss.Client) (interface{}, err
 
 		log.Printf("[DEBUG] Instantiate OSS client using endpoint: %#v", endpoint)
 		clientOptions := []oss.ClientOption{oss.UserAgent(client.getUserAgent()),
-			oss.SecurityToken(client.config.SecurityToken)}
+			oss.SecurityToken(config.SecurityToken)}
 		proxyUrl := client.getHttpProxyUrl()
 		if proxyUrl != nil {
 			clientOptions = append(clientOptions, oss.Proxy(proxyUrl.String()))
 		}
 
-		ossconn, err := oss.New(endpoint, client.config.AccessKey, client.config.SecretKey, clientOptions...)
+		ossconn, err := oss.New(endpoint, config.AccessKey, config.SecretKey, clientOptions...)
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the OSS client: %#v", err)
 		}
-
-		client.ossconn = ossconn
+		return ossconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.ossconn)
+	return client.invoke(OSSCode, func() (interface{}, error) {
+		return do(conn.(*oss.Client))
+	})
 }
 
 func (client *AliyunClient) WithOssBucketByName(bucketName string, do func(*oss.Bucket) (interface{}, error)) (interface{}, error) {
 	return client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
-		bucket, err := client.ossconn.Bucket(bucketName)
+		bucket, err := ossClient.Bucket(bucketName)
 		if err != nil {
 			return nil, fmt.Errorf("unable to get the bucket %s: %#v", bucketName, err)
 		}
@@ -314,16 +418,13 @@ func (client *AliyunClient) WithOssBucketByName(bucketName string, do func(*oss.
 }
 
 func (client *AliyunClient) WithDnsClient(do func(*dns.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the DNS client if necessary
-	if client.dnsconn == nil {
-		dnsconn := dns.NewClientNew(client.config.AccessKey, client.config.SecretKey)
+	conn, err := client.getConn(string(DNSCode), func(config *Config) (interface{}, error) {
+		dnsconn := dns.NewClientNew(config.AccessKey, config.SecretKey)
 		dnsconn.SetBusinessInfo(businessInfoKey)
 		dnsconn.SetUserAgent(client.getUserAgent())
-		dnsconn.SetSecurityToken(client.config.SecurityToken)
-		endpoint := loadEndpoint(client.config.RegionId, DNSCode)
+		dnsconn.SetSecurityToken(config.SecurityToken)
+		endpoint := config.loadEndpoint(DNSCode)
 		if endpoint == "" {
 			endpoint = "alidns.aliyuncs.com"
 		}
@@ -331,276 +432,258 @@ func (client *AliyunClient) WithDnsClient(do func(*dns.Client) (interface{}, err
 			endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://"))
 		}
 		dnsconn.SetEndpoint(endpoint)
-
-		client.dnsconn = dnsconn
+		return dnsconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.dnsconn)
+	return client.invoke(DNSCode, func() (interface{}, error) {
+		return do(conn.(*dns.Client))
+	})
 }
 
 func (client *AliyunClient) WithRamClient(do func(ram.RamClientInterface) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the RAM client if necessary
-	if client.ramconn == nil {
-		endpoint := strings.TrimSpace(loadEndpoint(client.config.RegionId, RAMCode))
+	conn, err := client.getConn(string(RAMCode), func(config *Config) (interface{}, error) {
+		endpoint := strings.TrimSpace(config.loadEndpoint(RAMCode))
 		if endpoint == "" {
 			endpoint = ram.RAMDefaultEndpoint
 		}
 		if !strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://"))
 		}
-		ramconn := ram.NewClientWithEndpointAndSecurityToken(endpoint, client.config.AccessKey, client.config.SecretKey, client.config.SecurityToken)
-		client.ramconn = ramconn
+		return ram.NewClientWithEndpointAndSecurityToken(endpoint, config.AccessKey, config.SecretKey, config.SecurityToken), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.ramconn)
+	return client.invoke(RAMCode, func() (interface{}, error) {
+		return do(conn.(ram.RamClientInterface))
+	})
 }
 
 func (client *AliyunClient) WithCsClient(do func(*cs.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the CS client if necessary
-	if client.csconn == nil {
-		csconn := cs.NewClientForAussumeRole(client.config.AccessKey, client.config.SecretKey, client.config.SecurityToken)
+	conn, err := client.getConn(string(CONTAINCode), func(config *Config) (interface{}, error) {
+		csconn := cs.NewClientForAussumeRole(config.AccessKey, config.SecretKey, config.SecurityToken)
 		csconn.SetUserAgent(client.getUserAgent())
-		client.csconn = csconn
+		return csconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.csconn)
+	return client.invoke(CONTAINCode, func() (interface{}, error) {
+		return do(conn.(*cs.Client))
+	})
 }
 
 func (client *AliyunClient) WithCdnClient(do func(*cdn.CdnClient) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the CDN client if necessary
-	if client.cdnconn == nil {
-		cdnconn := cdn.NewClient(client.config.AccessKey, client.config.SecretKey)
+	conn, err := client.getConn(string(CDNCode), func(config *Config) (interface{}, error) {
+		cdnconn := cdn.NewClient(config.AccessKey, config.SecretKey)
 		cdnconn.SetBusinessInfo(businessInfoKey)
 		cdnconn.SetUserAgent(client.getUserAgent())
-		cdnconn.SetSecurityToken(client.config.SecurityToken)
-		endpoint := loadEndpoint(client.config.RegionId, CDNCode)
+		cdnconn.SetSecurityToken(config.SecurityToken)
+		endpoint := config.loadEndpoint(CDNCode)
 		if endpoint != "" && !strings.HasPrefix(endpoint, "http") {
 			cdnconn.SetEndpoint(fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://")))
 		}
-		client.cdnconn = cdnconn
+		return cdnconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.cdnconn)
+	return client.invoke(CDNCode, func() (interface{}, error) {
+		return do(conn.(*cdn.CdnClient))
+	})
 }
 
 func (client *AliyunClient) WithKmsClient(do func(*kms.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the KMS client if necessary
-	if client.kmsconn == nil {
-		kmsconn := kms.NewECSClientWithSecurityToken(client.config.AccessKey, client.config.SecretKey, client.config.SecurityToken, common.Region(client.config.RegionId))
+	conn, err := client.getConn(string(KMSCode), func(config *Config) (interface{}, error) {
+		kmsconn := kms.NewECSClientWithSecurityToken(config.AccessKey, config.SecretKey, config.SecurityToken, common.Region(config.RegionId))
 		kmsconn.SetBusinessInfo(businessInfoKey)
 		kmsconn.SetUserAgent(client.getUserAgent())
-		endpoint := loadEndpoint(client.config.RegionId, KMSCode)
+		endpoint := config.loadEndpoint(KMSCode)
 		if endpoint != "" && !strings.HasPrefix(endpoint, "http") {
 			kmsconn.SetEndpoint(fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://")))
 		}
-		client.kmsconn = kmsconn
+		return kmsconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.kmsconn)
+	return client.invoke(KMSCode, func() (interface{}, error) {
+		return do(conn.(*kms.Client))
+	})
 }
 
 func (client *AliyunClient) WithOtsClient(do func(*ots.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the OTS client if necessary
-	if client.otsconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, OTSCode)
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(OTSCode), endpoint)
-		}
-		otsconn, err := ots.NewClientWithOptions(client.config.RegionId, client.getSdkConfig().WithScheme("HTTP"), client.config.getAuthCredential(true))
+	conn, err := client.getConn(string(OTSCode), func(config *Config) (interface{}, error) {
+		otsconn, err := ots.NewClientWithOptions(config.RegionId, client.getSdkConfig().WithScheme("HTTP"), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the OTS client: %#v", err)
 		}
-
-		client.otsconn = otsconn
+		return otsconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.otsconn)
+	return client.invoke(OTSCode, func() (interface{}, error) {
+		return do(conn.(*ots.Client))
+	})
 }
 
 func (client *AliyunClient) WithCmsClient(do func(*cms.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the CMS client if necessary
-	if client.cmsconn == nil {
-		cmsconn, err := cms.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(false))
+	conn, err := client.getConn(string(CMSCode), func(config *Config) (interface{}, error) {
+		cmsconn, err := cms.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(false))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the CMS client: %#v", err)
 		}
-
-		client.cmsconn = cmsconn
+		return cmsconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.cmsconn)
+	return client.invoke(CMSCode, func() (interface{}, error) {
+		return do(conn.(*cms.Client))
+	})
 }
 
 func (client *AliyunClient) WithPvtzClient(do func(*pvtz.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the PVTZ client if necessary
-	if client.pvtzconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, PVTZCode)
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(PVTZCode), endpoint)
-		} else {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(PVTZCode), "pvtz.aliyuncs.com")
-		}
-		pvtzconn, err := pvtz.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	conn, err := client.getConn(string(PVTZCode), func(config *Config) (interface{}, error) {
+		pvtzconn, err := pvtz.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the PVTZ client: %#v", err)
 		}
-
-		client.pvtzconn = pvtzconn
+		return pvtzconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.pvtzconn)
+	return client.invoke(PVTZCode, func() (interface{}, error) {
+		return do(conn.(*pvtz.Client))
+	})
 }
 
 func (client *AliyunClient) WithStsClient(do func(*sts.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the STS client if necessary
-	if client.stsconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, STSCode)
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(STSCode), endpoint)
-		}
-		stsconn, err := sts.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	// The STS client always uses the credential configured in the provider, so that it is able to assume a role at any time.
+	conn, err := client.getConnWithConfig(string(STSCode), client.getSourceConfig(), func(config *Config) (interface{}, error) {
+		stsconn, err := sts.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the STS client: %#v", err)
 		}
-
-		client.stsconn = stsconn
+		return stsconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.stsconn)
+	return client.invoke(STSCode, func() (interface{}, error) {
+		return do(conn.(*sts.Client))
+	})
 }
 
 func (client *AliyunClient) WithLogClient(do func(*sls.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the LOG client if necessary
-	if client.logconn == nil {
-		endpoint := client.config.LogEndpoint
+	conn, err := client.getConn(string(LOGCode), func(config *Config) (interface{}, error) {
+		endpoint := config.loadEndpoint(LOGCode)
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, LOGCode)
-			if endpoint == "" {
-				endpoint = fmt.Sprintf("%s.log.aliyuncs.com", client.config.RegionId)
-			}
+			endpoint = fmt.Sprintf("%s.log.aliyuncs.com", config.RegionId)
 		}
 		if !strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://"))
 		}
-		client.logconn = &sls.Client{
-			AccessKeyID:     client.config.AccessKey,
-			AccessKeySecret: client.config.SecretKey,
+		return &sls.Client{
+			AccessKeyID:     config.AccessKey,
+			AccessKeySecret: config.SecretKey,
 			Endpoint:        endpoint,
-			SecurityToken:   client.config.SecurityToken,
+			SecurityToken:   config.SecurityToken,
 			UserAgent:       client.getUserAgent(),
-		}
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.logconn)
+	return client.invoke(LOGCode, func() (interface{}, error) {
+		return do(conn.(*sls.Client))
+	})
 }
 
 func (client *AliyunClient) WithDrdsClient(do func(*drds.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the DRDS client if necessary
-	if client.drdsconn == nil {
-		endpoint := client.config.DRDSEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, DRDSCode)
-			if endpoint == "" {
-				endpoint = fmt.Sprintf("%s.drds.aliyuncs.com", client.config.RegionId)
-			}
-		}
-
-		drdsconn, err := drds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	conn, err := client.getConn(string(DRDSCode), func(config *Config) (interface{}, error) {
+		drdsconn, err := drds.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the DRDS client: %#v", err)
 
 		}
-
-		client.drdsconn = drdsconn
+		return drdsconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.drdsconn)
+	return client.invoke(DRDSCode, func() (interface{}, error) {
+		return do(conn.(*drds.Client))
+	})
 }
 
 func (client *AliyunClient) WithDdsClient(do func(*dds.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the DDS client if necessary
-	if client.ddsconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, DDSCode)
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(DDSCode), endpoint)
-		}
-		ddsconn, err := dds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	conn, err := client.getConn(string(DDSCode), func(config *Config) (interface{}, error) {
+		ddsconn, err := dds.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the DDS client: %#v", err)
 		}
-
-		client.ddsconn = ddsconn
+		return ddsconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.ddsconn)
+	return client.invoke(DDSCode, func() (interface{}, error) {
+		return do(conn.(*dds.Client))
+	})
 }
 
 func (client *AliyunClient) WithRkvClient(do func(*r_kvstore.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the RKV client if necessary
-	if client.rkvconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, KVSTORECode)
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, fmt.Sprintf("R-%s", string(KVSTORECode)), endpoint)
-		}
-		rkvconn, err := r_kvstore.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	conn, err := client.getConn(string(KVSTORECode), func(config *Config) (interface{}, error) {
+		rkvconn, err := r_kvstore.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the RKV client: %#v", err)
 		}
-
-		client.rkvconn = rkvconn
+		return rkvconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.rkvconn)
+	return client.invoke(KVSTORECode, func() (interface{}, error) {
+		return do(conn.(*r_kvstore.Client))
+	})
 }
 
 func (client *AliyunClient) WithFcClient(do func(*fc.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the FC client if necessary
-	if client.fcconn == nil {
-		endpoint := client.config.FcEndpoint
+	conn, err := client.getConn(string(FCCode), func(config *Config) (interface{}, error) {
+		endpoint := config.loadEndpoint(FCCode)
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, FCCode)
-			if endpoint == "" {
-				endpoint = fmt.Sprintf("%s.fc.aliyuncs.com", client.config.RegionId)
-			}
+			endpoint = fmt.Sprintf("%s.fc.aliyuncs.com", config.RegionId)
 		}
 		if strings.HasPrefix(endpoint, "http") {
 			endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
@@ -610,14 +693,14 @@ func (client *AliyunClient) WithFcClient(do func(*fc.Client) (interface{}, error
 			return nil, err
 		}
 
-		config := client.getSdkConfig()
+		sdkConfig := client.getSdkConfig()
 		fcconn, err := fc.NewClient(
 			fmt.Sprintf("https://%s.%s", accountId, endpoint),
 			string(ApiVersion20160815),
-			client.config.AccessKey,
-			client.config.SecretKey,
-			fc.WithSecurityToken(client.config.SecurityToken),
-			fc.WithTransport(config.HttpTransport),
+			config.AccessKey,
+			config.SecretKey,
+			fc.WithSecurityToken(config.SecurityToken),
+			fc.WithTransport(sdkConfig.HttpTransport),
 			fc.WithTimeout(30),
 			fc.WithRetryCount(DefaultClientRetryCountSmall))
 		if err != nil {
@@ -625,74 +708,72 @@ func (client *AliyunClient) WithFcClient(do func(*fc.Client) (interface{}, error
 		}
 
 		fcconn.Config.UserAgent = client.getUserAgent()
-		fcconn.Config.SecurityToken = client.config.SecurityToken
-		client.fcconn = fcconn
+		fcconn.Config.SecurityToken = config.SecurityToken
+		return fcconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.fcconn)
+	return client.invoke(FCCode, func() (interface{}, error) {
+		return do(conn.(*fc.Client))
+	})
 }
 
 func (client *AliyunClient) WithCloudApiClient(do func(*cloudapi.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the Cloud API client if necessary
-	if client.cloudapiconn == nil {
-		endpoint := loadEndpoint(client.RegionId, CLOUDAPICode)
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.RegionId, fmt.Sprintf("R-%s", string(CLOUDAPICode)), endpoint)
-		}
-		cloudapiconn, err := cloudapi.NewClientWithOptions(client.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	conn, err := client.getConn(string(CLOUDAPICode), func(config *Config) (interface{}, error) {
+		cloudapiconn, err := cloudapi.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the CloudAPI client: %#v", err)
 		}
-
-		client.cloudapiconn = cloudapiconn
+		return cloudapiconn, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.cloudapiconn)
+	return client.invoke(CLOUDAPICode, func() (interface{}, error) {
+		return do(conn.(*cloudapi.Client))
+	})
 }
 
 func (client *AliyunClient) WithDataHubClient(do func(*datahub.DataHub) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the DataHub client if necessary
-	if client.dhconn == nil {
-		endpoint := loadEndpoint(client.RegionId, DATAHUBCode)
+	conn, err := client.getConn(string(DATAHUBCode), func(config *Config) (interface{}, error) {
+		endpoint := config.loadEndpoint(DATAHUBCode)
 		if endpoint == "" {
-			if client.RegionId == string(APSouthEast1) {
+			if config.RegionId == string(APSouthEast1) {
 				endpoint = "dh-singapore.aliyuncs.com"
 			} else {
-				endpoint = fmt.Sprintf("dh-%s.aliyuncs.com", client.RegionId)
+				endpoint = fmt.Sprintf("dh-%s.aliyuncs.com", config.RegionId)
 			}
 		}
 		if !strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", endpoint)
 		}
-		account := datahub.NewStsCredential(client.AccessKey, client.SecretKey, client.SecurityToken)
-		config := &datahub.Config{
+		account := datahub.NewStsCredential(config.AccessKey, config.SecretKey, config.SecurityToken)
+		dhConfig := &datahub.Config{
 			UserAgent: client.getUserAgent(),
 		}
 
-		client.dhconn = datahub.NewClientWithConfig(endpoint, config, account)
+		return datahub.NewClientWithConfig(endpoint, dhConfig, account), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.dhconn)
+	return client.invoke(DATAHUBCode, func() (interface{}, error) {
+		return do(conn.(*datahub.DataHub))
+	})
 }
 
 func (client *AliyunClient) WithMnsClient(do func(*ali_mns.MNSClient) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the MNS client if necessary
-	if client.mnsconn == nil {
-		endpoint := client.config.MNSEndpoint
+	conn, err := client.getConn(string(MNSCode), func(config *Config) (interface{}, error) {
+		endpoint := config.loadEndpoint(MNSCode)
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, MNSCode)
-			if endpoint == "" {
-				endpoint = fmt.Sprintf("%s.aliyuncs.com", client.config.RegionId)
-			}
+			endpoint = fmt.Sprintf("%s.aliyuncs.com", config.RegionId)
 		}
 
 		accountId, err := client.AccountId()
@@ -704,12 +785,16 @@ func (client *AliyunClient) WithMnsClient(do func(*ali_mns.MNSClient) (interface
 		}
 		mnsUrl := fmt.Sprintf("https://%s.mns.%s", accountId, endpoint)
 
-		mnsClient := ali_mns.NewAliMNSClient(mnsUrl, client.config.AccessKey, client.config.SecretKey)
-
-		client.mnsconn = &mnsClient
+		mnsClient := ali_mns.NewAliMNSClient(mnsUrl, config.AccessKey, config.SecretKey)
+		return &mnsClient, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(client.mnsconn)
+	return client.invoke(MNSCode, func() (interface{}, error) {
+		return do(conn.(*ali_mns.MNSClient))
+	})
 }
 
 func (client *AliyunClient) WithMnsQueueManager(do func(ali_mns.AliQueueManager) (interface{}, error)) (interface{}, error) {
@@ -734,50 +819,154 @@ func (client *AliyunClient) WithMnsSubscriptionManagerByTopicName(topicName stri
 }
 
 func (client *AliyunClient) WithTableStoreClient(instanceName string, do func(*tablestore.TableStoreClient) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the TABLESTORE client if necessary
-	tableStoreClient, ok := client.tablestoreconnByInstanceName[instanceName]
-	if !ok {
-		endpoint := loadEndpoint(client.RegionId, OTSCode)
+	conn, err := client.getConn(fmt.Sprintf("TABLESTORE|%s", instanceName), func(config *Config) (interface{}, error) {
+		endpoint := config.loadEndpoint(OTSCode)
 		if endpoint == "" {
-			endpoint = fmt.Sprintf("%s.%s.ots.aliyuncs.com", instanceName, client.RegionId)
+			endpoint = fmt.Sprintf("%s.%s.ots.aliyuncs.com", instanceName, config.RegionId)
 		}
 		if !strings.HasPrefix(endpoint, "https") && !strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", endpoint)
 		}
-		tableStoreClient = tablestore.NewClientWithConfig(endpoint, instanceName, client.AccessKey, client.SecretKey, client.SecurityToken, tablestore.NewDefaultTableStoreConfig())
-		client.tablestoreconnByInstanceName[instanceName] = tableStoreClient
+		return tablestore.NewClientWithConfig(endpoint, instanceName, config.AccessKey, config.SecretKey, config.SecurityToken, tablestore.NewDefaultTableStoreConfig()), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return do(tableStoreClient)
+	return client.invoke(OTSCode, func() (interface{}, error) {
+		return do(conn.(*tablestore.TableStoreClient))
+	})
 }
 
 func (client *AliyunClient) WithCsProjectClient(clusterId, endpoint string, clusterCerts cs.ClusterCerts, do func(*cs.ProjectClient) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
 	// Initialize the PROJECT client if necessary
-	key := fmt.Sprintf("%s|%s|%s|%s|%s", clusterId, endpoint, clusterCerts.CA, clusterCerts.Cert, clusterCerts.Key)
-	csProjectClient, ok := client.csprojectconnByKey[key]
-	if !ok {
-		var err error
-		csProjectClient, err = cs.NewProjectClient(clusterId, endpoint, clusterCerts)
+	key := fmt.Sprintf("CSPROJECT|%s|%s|%s|%s|%s", clusterId, endpoint, clusterCerts.CA, clusterCerts.Cert, clusterCerts.Key)
+	conn, err := client.getConn(key, func(config *Config) (interface{}, error) {
+		csProjectClient, err := cs.NewProjectClient(clusterId, endpoint, clusterCerts)
 		if err != nil {
 			return nil, fmt.Errorf("Getting Application Client failed by cluster id %s: %#v.", clusterCerts, err)
 		}
 		csProjectClient.SetDebug(false)
 		csProjectClient.SetUserAgent(client.getUserAgent())
-		client.csprojectconnByKey[key] = csProjectClient
+		return csProjectClient, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.invoke(CONTAINCode, func() (interface{}, error) {
+		return do(conn.(*cs.ProjectClient))
+	})
+}
+
+// renewCredential fetches a new temporary credential of the ECS RAM role and/or assumes the configured
+// RAM role again. The service clients built with the previous credential are rebuilt at their next use.
+func (client *AliyunClient) renewCredential() error {
+	var expiredAt time.Time
+	source := client.getSourceConfig()
+	if source.EcsRoleName != "" {
+		renewed := *source
+		expiration, err := renewed.loadEcsRoleCredential()
+		if err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Loaded the credential of the ECS RAM role %s, it expires at %s.", renewed.EcsRoleName, expiration)
+
+		client.configMutex.Lock()
+		client.sourceConfig = &renewed
+		client.configMutex.Unlock()
+		source = &renewed
+		expiredAt = expiration
+	}
+
+	config := source
+	if source.RamRoleArn != "" {
+		assumed, expiration, err := client.assumeRole()
+		if err != nil {
+			return err
+		}
+		config = assumed
+		expiredAt = expiration
+	}
+
+	client.setCredential(config, expiredAt)
+	return nil
+}
+
+// assumeRole exchanges the source credential for a temporary credential of the configured RAM role.
+func (client *AliyunClient) assumeRole() (*Config, time.Time, error) {
+	source := client.getSourceConfig()
+	request := sts.CreateAssumeRoleRequest()
+	request.RoleArn = source.RamRoleArn
+	request.RoleSessionName = source.RamRoleSessionName
+	if request.RoleSessionName == "" {
+		request.RoleSessionName = DefaultRoleSessionName
+	}
+	request.Policy = source.RamRolePolicy
+	expiration := source.RamRoleSessionExpiration
+	if expiration <= 0 {
+		expiration = DefaultRoleSessionExpiration
+	}
+	request.DurationSeconds = requests.NewInteger(expiration)
+
+	raw, err := client.WithStsClient(func(stsClient *sts.Client) (interface{}, error) {
+		return stsClient.AssumeRole(request)
+	})
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("AssumeRole %s got an error: %#v", request.RoleArn, err)
+	}
+	response, _ := raw.(*sts.AssumeRoleResponse)
+	if response == nil || response.Credentials.AccessKeyId == "" {
+		return nil, time.Time{}, fmt.Errorf("AssumeRole %s got an empty credential.", request.RoleArn)
+	}
+
+	expiredAt, err := time.Parse(time.RFC3339, response.Credentials.Expiration)
+	if err != nil {
+		expiredAt = time.Now().Add(time.Duration(expiration) * time.Second)
+	}
+	log.Printf("[DEBUG] Assumed role %s with session %s, the credential expires at %s.", request.RoleArn, request.RoleSessionName, expiredAt)
+
+	config := *source
+	config.AccessKey = response.Credentials.AccessKeyId
+	config.SecretKey = response.Credentials.AccessKeySecret
+	config.SecurityToken = response.Credentials.SecurityToken
+	return &config, expiredAt, nil
+}
+
+// setCredential switches the client to a new credential.
+func (client *AliyunClient) setCredential(config *Config, expiredAt time.Time) {
+	client.configMutex.Lock()
+	defer client.configMutex.Unlock()
+
+	client.config = config
+	client.credentialExpiration = expiredAt
+}
+
+// renewCredentialIfExpired renews the temporary credential of the ECS RAM role or the assumed role before it expires,
+// so that an apply running longer than one credential session does not fail.
+func (client *AliyunClient) renewCredentialIfExpired() error {
+	source := client.getSourceConfig()
+	if source == nil || (source.EcsRoleName == "" && source.RamRoleArn == "") {
+		return nil
 	}
 
-	return do(csProjectClient)
+	client.credentialMutex.Lock()
+	defer client.credentialMutex.Unlock()
+
+	client.configMutex.RLock()
+	expiredAt := client.credentialExpiration
+	client.configMutex.RUnlock()
+	if time.Now().Add(CredentialRenewWindow).Before(expiredAt) {
+		return nil
+	}
+	log.Printf("[DEBUG] The temporary credential is going to expire, renewing it.")
+	return client.renewCredential()
 }
 
 func (client *AliyunClient) NewCommonRequest(product, serviceCode, schema string, apiVersion ApiVersion) *requests.CommonRequest {
 	request := requests.NewCommonRequest()
-	endpoint := loadEndpoint(client.RegionId, ServiceCode(strings.ToUpper(product)))
+	endpoint := client.getConfig().loadEndpoint(ServiceCode(strings.ToUpper(product)))
 	if endpoint == "" {
 		endpointItem, err := client.describeEndpointForService(serviceCode)
 		if err != nil {
@@ -821,12 +1010,21 @@ func (client *AliyunClient) AccountId() (string, error) {
 
 func (client *AliyunClient) getSdkConfig() *sdk.Config {
 	// Fix bug "open /usr/local/go/lib/time/zoneinfo.zip: no such file or directory" which happened in windows.
-	if data, ok := resource.GetTZData("GMT"); ok {
-		utils.TZData = data
-		utils.LoadLocationFromTZData = time.LoadLocationFromTZData
-	}
+	// The global time zone data of the SDK is only set once, and only when it is valid.
+	loadTZDataOnce.Do(func() {
+		if data, ok := resource.GetTZData("GMT"); ok {
+			if _, err := time.LoadLocationFromTZData("GMT", data); err != nil {
+				log.Printf("[WARN] Loading the embedded GMT time zone data got an error: %#v", err)
+				return
+			}
+			utils.TZData = data
+			utils.LoadLocationFromTZData = time.LoadLocationFromTZData
+		}
+	})
 	return sdk.NewConfig().
-		WithMaxRetryTime(DefaultClientRetryCountSmall).
+		// The throttling and unavailable service errors are retried by the retry policy of the provider with backoff,
+		// and the transport errors by the invokers of the resources, instead of the immediate retries of the SDK.
+		WithAutoRetry(false).
 		WithTimeout(time.Duration(30) * time.Second).
 		WithUserAgent(client.getUserAgent()).
 		WithGoRoutinePoolSize(10).
@@ -873,15 +1071,16 @@ func (client *AliyunClient) getHttpProxyUrl() *url.URL {
 }
 
 func (client *AliyunClient) describeEndpointForService(serviceCode string) (*location.DescribeEndpointResponse, error) {
+	config := client.getConfig()
 	args := location.CreateDescribeEndpointRequest()
 	args.ServiceCode = serviceCode
-	args.Id = client.config.RegionId
-	args.Domain = loadEndpoint(client.RegionId, LOCATIONCode)
+	args.Id = config.RegionId
+	args.Domain = config.loadEndpoint(LOCATIONCode)
 	if args.Domain == "" {
 		args.Domain = "location-readonly.aliyuncs.com"
 	}
 
-	locationClient, err := location.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	locationClient, err := location.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 	if err != nil {
 		return nil, fmt.Errorf("Unable to initialize the location client: %#v", err)
 
@@ -896,11 +1095,11 @@ func (client *AliyunClient) describeEndpointForService(serviceCode string) (*loc
 func (client *AliyunClient) getCallerIdentity() (*sts.GetCallerIdentityResponse, error) {
 	args := sts.CreateGetCallerIdentityRequest()
 
-	endpoint := loadEndpoint(client.config.RegionId, STSCode)
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, string(STSCode), endpoint)
+	if err := client.renewCredentialIfExpired(); err != nil {
+		return nil, err
 	}
-	stsClient, err := sts.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	config := client.getConfig()
+	stsClient, err := sts.NewClientWithOptions(config.RegionId, client.getSdkConfig(), config.getAuthCredential(true))
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the STS client: %#v", err)
 	}
@@ -0,0 +1,189 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+)
+
+const testConcurrency = 20
+const testCallsPerRoutine = 25
+
+// testApiServer is a stand-in of the OpenAPI gateway which records the access keys it has been called with.
+type testApiServer struct {
+	*httptest.Server
+	calls      int64
+	accessKeys sync.Map
+}
+
+func newTestApiServer() *testApiServer {
+	s := &testApiServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&s.calls, 1)
+		s.accessKeys.Store(r.URL.Query().Get("AccessKeyId"), true)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"RequestId":"%s-%d"}`, r.URL.Query().Get("Action"), atomic.LoadInt64(&s.calls))
+	}))
+	return s
+}
+
+func (s *testApiServer) host() string {
+	u, _ := url.Parse(s.URL)
+	return u.Host
+}
+
+func testAliyunClient(t *testing.T, config *Config) *AliyunClient {
+	if config.Region == "" {
+		config.Region = Beijing
+		config.RegionId = string(Beijing)
+	}
+	// The stand-in servers are not throttled, and the concurrency tests should not wait for the rate limits.
+	if config.ApiRateLimits == nil {
+		config.ApiRateLimits = make(map[ServiceCode]int)
+		for code := range DefaultApiRateLimits {
+			config.ApiRateLimits[code] = 0
+		}
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("building the client got an error: %#v", err)
+	}
+	return client
+}
+
+// testCallServices invokes the API of several services through the With*Client wrappers,
+// and records the service client passed to each call.
+func testCallServices(client *AliyunClient, host string, i int, conns *sync.Map) error {
+	var err error
+	switch i % 5 {
+	case 0:
+		_, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			conns.Store(fmt.Sprintf("%p", vpcClient), VPCCode)
+			request := vpc.CreateDescribeVpcsRequest()
+			request.Domain, request.Scheme = host, "http"
+			return vpcClient.DescribeVpcs(request)
+		})
+	case 1:
+		_, err = client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
+			conns.Store(fmt.Sprintf("%p", slbClient), SLBCode)
+			request := slb.CreateDescribeLoadBalancersRequest()
+			request.Domain, request.Scheme = host, "http"
+			return slbClient.DescribeLoadBalancers(request)
+		})
+	case 2:
+		_, err = client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			conns.Store(fmt.Sprintf("%p", rdsClient), RDSCode)
+			request := rds.CreateDescribeDBInstancesRequest()
+			request.Domain, request.Scheme = host, "http"
+			return rdsClient.DescribeDBInstances(request)
+		})
+	case 3:
+		_, err = client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+			conns.Store(fmt.Sprintf("%p", essClient), ESSCode)
+			request := ess.CreateDescribeScalingGroupsRequest()
+			request.Domain, request.Scheme = host, "http"
+			return essClient.DescribeScalingGroups(request)
+		})
+	case 4:
+		_, err = client.WithCenClient(func(cenClient *cbn.Client) (interface{}, error) {
+			conns.Store(fmt.Sprintf("%p", cenClient), CENCode)
+			request := cbn.CreateDescribeCensRequest()
+			request.Domain, request.Scheme = host, "http"
+			return cenClient.DescribeCens(request)
+		})
+	}
+	return err
+}
+
+func testHammerClient(t *testing.T, client *AliyunClient, server *testApiServer) *sync.Map {
+	conns := &sync.Map{}
+	errs := make(chan error, testConcurrency*testCallsPerRoutine)
+
+	var wg sync.WaitGroup
+	for g := 0; g < testConcurrency; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < testCallsPerRoutine; i++ {
+				if err := testCallServices(client, server.host(), g+i, conns); err != nil {
+					errs <- err
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("calling the API got an error: %#v", err)
+	}
+	if calls := atomic.LoadInt64(&server.calls); calls != testConcurrency*testCallsPerRoutine {
+		t.Errorf("expected %d API calls, got %d", testConcurrency*testCallsPerRoutine, calls)
+	}
+	return conns
+}
+
+func TestAliyunClient_concurrentCalls(t *testing.T) {
+	server := newTestApiServer()
+	defer server.Close()
+	client := testAliyunClient(t, &Config{AccessKey: "ak", SecretKey: "sk"})
+
+	conns := testHammerClient(t, client, server)
+
+	// Each service client is built only once and shared by all of the calls.
+	count := make(map[ServiceCode]int)
+	conns.Range(func(_, code interface{}) bool {
+		count[code.(ServiceCode)]++
+		return true
+	})
+	for _, code := range []ServiceCode{VPCCode, SLBCode, RDSCode, ESSCode, CENCode} {
+		if count[code] != 1 {
+			t.Errorf("expected one %s client, got %d", code, count[code])
+		}
+	}
+}
+
+func TestAliyunClient_concurrentCallsDuringCredentialRenewal(t *testing.T) {
+	server := newTestApiServer()
+	defer server.Close()
+
+	// Every credential returned by the metadata stand-in is inside the renew window,
+	// so that the calls keep renewing it while the others are in flight.
+	var renewals int64
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt64(&renewals, 1)
+		fmt.Fprintf(w, `{"Code":"Success","AccessKeyId":"ecs-ak-%d","AccessKeySecret":"ecs-sk","SecurityToken":"ecs-token","Expiration":"%s"}`,
+			n, time.Now().Add(CredentialRenewWindow/2).UTC().Format(time.RFC3339))
+	}))
+	defer metadata.Close()
+
+	client := testAliyunClient(t, &Config{EcsRoleName: "ci-runner", EcsMetadataEndpoint: metadata.URL, SharedCredentialsFile: "/not/exist/config.json"})
+
+	testHammerClient(t, client, server)
+
+	if atomic.LoadInt64(&renewals) < 2 {
+		t.Errorf("expected the credential to be renewed, got %d renewals", renewals)
+	}
+	keys := 0
+	server.accessKeys.Range(func(_, _ interface{}) bool {
+		keys++
+		return true
+	})
+	if keys < 2 {
+		t.Errorf("expected the calls to use the renewed credentials, got %d access keys", keys)
+	}
+	if accessKey, _, _ := client.Credential(); accessKey == client.AccessKey {
+		t.Errorf("expected the renewed credential to differ from the one the client is built with, got %s", accessKey)
+	}
+}
@@ -2,6 +2,7 @@ package connectivity
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
@@ -15,15 +16,45 @@ type Config struct {
 	RegionId        string
 	SecurityToken   string
 	OtsInstanceName string
-	LogEndpoint     string
 	AccountId       string
-	FcEndpoint      string
-	MNSEndpoint     string
-	DRDSEndpoint    string
+
+	// Endpoints holds the self-defined endpoints of the services set in the provider block.
+	Endpoints map[ServiceCode]string
+
+	RamRoleArn               string
+	RamRoleSessionName       string
+	RamRolePolicy            string
+	RamRoleSessionExpiration int
+
+	Profile               string
+	SharedCredentialsFile string
+	EcsRoleName           string
+	EcsMetadataEndpoint   string
+
+	// MaxRetries, RetryBaseDelay and RetryMaxDelay configure the backoff of the throttled or failed API calls.
+	MaxRetries     int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+
+	// ApiTraceFile is the JSON-lines file which the API calls are traced to. The traces go to the log if it is empty.
+	ApiTraceFile string
+	// ApiTracer replaces the tracer of the API calls, if it is set.
+	ApiTracer ApiTracer
+
+	// DefaultTags are merged into the tags of every resource which supports tags.
+	DefaultTags map[string]string
+
+	// ApiRateLimits overrides the maximum queries per second of the products in DefaultApiRateLimits. 0 means no limit.
+	ApiRateLimits map[ServiceCode]int
 }
 
 func (c *Config) loadAndValidate() error {
-	err := c.validateRegion()
+	err := c.loadCredential()
+	if err != nil {
+		return err
+	}
+
+	err = c.validateRegion()
 	if err != nil {
 		return err
 	}
@@ -43,7 +74,8 @@ func (c *Config) validateRegion() error {
 }
 
 func (c *Config) getAuthCredential(stsSupported bool) auth.Credential {
-	if stsSupported {
+	// Temporary credentials, like the ones of an assumed role, can not be used without the security token.
+	if stsSupported || c.SecurityToken != "" {
 		return credentials.NewStsTokenCredential(c.AccessKey, c.SecretKey, c.SecurityToken)
 	}
 
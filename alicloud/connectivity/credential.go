@@ -0,0 +1,252 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+const DefaultSharedCredentialsFile = "~/.aliyun/config.json"
+
+const DefaultEcsMetadataEndpoint = "http://100.100.100.200/latest/meta-data/"
+
+const ecsMetadataTimeout = 5 * time.Second
+
+// Credential modes supported by the profiles of aliyun CLI.
+const (
+	ProfileModeAK         = "AK"
+	ProfileModeStsToken   = "StsToken"
+	ProfileModeRamRoleArn = "RamRoleArn"
+	ProfileModeEcsRamRole = "EcsRamRole"
+)
+
+// SharedCredentials is the configuration file written by aliyun CLI, ~/.aliyun/config.json by default.
+type SharedCredentials struct {
+	Current  string                  `json:"current"`
+	Profiles []SharedCredentialsItem `json:"profiles"`
+}
+
+type SharedCredentialsItem struct {
+	Name            string `json:"name"`
+	Mode            string `json:"mode"`
+	AccessKeyId     string `json:"access_key_id"`
+	AccessKeySecret string `json:"access_key_secret"`
+	StsToken        string `json:"sts_token"`
+	RamRoleName     string `json:"ram_role_name"`
+	RamRoleArn      string `json:"ram_role_arn"`
+	RamSessionName  string `json:"ram_session_name"`
+	ExpiredSeconds  int    `json:"expired_seconds"`
+	RegionId        string `json:"region_id"`
+}
+
+// EcsRoleCredential is the temporary credential of a RAM role returned by the ECS instance metadata.
+type EcsRoleCredential struct {
+	Code            string `json:"Code"`
+	AccessKeyId     string `json:"AccessKeyId"`
+	AccessKeySecret string `json:"AccessKeySecret"`
+	SecurityToken   string `json:"SecurityToken"`
+	Expiration      string `json:"Expiration"`
+}
+
+// loadCredential resolves the credential of the provider by the following ordered chain:
+// the static access key in the provider block, the environment variables, the profile of
+// the shared credentials file and the RAM role attached to the ECS instance.
+// The first source which offers an access key wins.
+func (c *Config) loadCredential() error {
+	if c.AccessKey != "" && c.SecretKey != "" {
+		log.Printf("[DEBUG] Using the static credential of the provider block.")
+		return nil
+	}
+
+	if accessKey, secretKey := strings.TrimSpace(os.Getenv("ALICLOUD_ACCESS_KEY")), strings.TrimSpace(os.Getenv("ALICLOUD_SECRET_KEY")); accessKey != "" && secretKey != "" {
+		log.Printf("[DEBUG] Using the credential of the environment variables.")
+		c.AccessKey = accessKey
+		c.SecretKey = secretKey
+		if c.SecurityToken == "" {
+			c.SecurityToken = strings.TrimSpace(os.Getenv("ALICLOUD_SECURITY_TOKEN"))
+		}
+		return nil
+	}
+
+	found, err := c.loadCredentialFromProfile()
+	if err != nil || found {
+		return err
+	}
+
+	if c.EcsRoleName == "" {
+		roleName, err := c.getEcsRoleName()
+		if err != nil {
+			return fmt.Errorf("No valid credential sources found for the alicloud provider. Please set 'access_key' and 'secret_key', " +
+				"the environment variables ALICLOUD_ACCESS_KEY and ALICLOUD_SECRET_KEY, a 'profile' of the shared credentials file or an 'ecs_role_name'.")
+		}
+		c.EcsRoleName = roleName
+	}
+	log.Printf("[DEBUG] Using the credential of the ECS RAM role %s.", c.EcsRoleName)
+	return nil
+}
+
+// loadCredentialFromProfile reads the credential from a profile of the shared credentials file.
+// It returns false without error when the file does not exist and no profile is specified explicitly.
+func (c *Config) loadCredentialFromProfile() (bool, error) {
+	path := c.SharedCredentialsFile
+	if path == "" {
+		path = DefaultSharedCredentialsFile
+	}
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return false, fmt.Errorf("Expanding the shared credentials file %s got an error: %#v", path, err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && c.Profile == "" {
+			return false, nil
+		}
+		return false, fmt.Errorf("Reading the shared credentials file %s got an error: %#v", path, err)
+	}
+
+	var credentials SharedCredentials
+	if err := json.Unmarshal(data, &credentials); err != nil {
+		return false, fmt.Errorf("Parsing the shared credentials file %s got an error: %#v", path, err)
+	}
+
+	name := c.Profile
+	if name == "" {
+		name = credentials.Current
+	}
+	if name == "" {
+		name = "default"
+	}
+
+	var profile *SharedCredentialsItem
+	for i, p := range credentials.Profiles {
+		if p.Name == name {
+			profile = &credentials.Profiles[i]
+			break
+		}
+	}
+	if profile == nil {
+		if c.Profile == "" {
+			return false, nil
+		}
+		return false, fmt.Errorf("The profile %s is not found in the shared credentials file %s.", name, path)
+	}
+
+	log.Printf("[DEBUG] Using the credential of the profile %s in %s.", name, path)
+	if c.RegionId == "" && profile.RegionId != "" {
+		c.RegionId = profile.RegionId
+		c.Region = Region(profile.RegionId)
+	}
+
+	switch profile.Mode {
+	case "", ProfileModeAK:
+		c.AccessKey = profile.AccessKeyId
+		c.SecretKey = profile.AccessKeySecret
+	case ProfileModeStsToken:
+		c.AccessKey = profile.AccessKeyId
+		c.SecretKey = profile.AccessKeySecret
+		c.SecurityToken = profile.StsToken
+	case ProfileModeRamRoleArn:
+		c.AccessKey = profile.AccessKeyId
+		c.SecretKey = profile.AccessKeySecret
+		// The assume_role block of the provider takes precedence over the role of the profile.
+		if c.RamRoleArn == "" {
+			c.RamRoleArn = profile.RamRoleArn
+			c.RamRoleSessionName = profile.RamSessionName
+			c.RamRoleSessionExpiration = profile.ExpiredSeconds
+		}
+	case ProfileModeEcsRamRole:
+		if c.EcsRoleName == "" {
+			c.EcsRoleName = profile.RamRoleName
+		}
+		if c.EcsRoleName == "" {
+			roleName, err := c.getEcsRoleName()
+			if err != nil {
+				return false, err
+			}
+			c.EcsRoleName = roleName
+		}
+		return true, nil
+	default:
+		return false, fmt.Errorf("The mode %s of the profile %s is not supported.", profile.Mode, name)
+	}
+
+	if c.AccessKey == "" || c.SecretKey == "" {
+		return false, fmt.Errorf("The profile %s does not contain any access key.", name)
+	}
+	return true, nil
+}
+
+// getEcsRoleName returns the RAM role attached to the ECS instance which the provider is running on.
+func (c *Config) getEcsRoleName() (string, error) {
+	body, err := c.getEcsMetadata("ram/security-credentials/")
+	if err != nil {
+		return "", err
+	}
+	roleName := strings.TrimSpace(strings.Split(strings.TrimSpace(string(body)), "\n")[0])
+	if roleName == "" {
+		return "", fmt.Errorf("There is no RAM role attached to the ECS instance.")
+	}
+	return roleName, nil
+}
+
+// loadEcsRoleCredential fetches the temporary credential of the ECS RAM role from the instance metadata,
+// and returns the time at which the credential expires.
+func (c *Config) loadEcsRoleCredential() (time.Time, error) {
+	body, err := c.getEcsMetadata("ram/security-credentials/" + c.EcsRoleName)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var credential EcsRoleCredential
+	if err := json.Unmarshal(body, &credential); err != nil {
+		return time.Time{}, fmt.Errorf("Parsing the credential of the ECS RAM role %s got an error: %#v", c.EcsRoleName, err)
+	}
+	if credential.Code != "" && credential.Code != "Success" {
+		return time.Time{}, fmt.Errorf("Getting the credential of the ECS RAM role %s failed with code %s.", c.EcsRoleName, credential.Code)
+	}
+	if credential.AccessKeyId == "" || credential.AccessKeySecret == "" {
+		return time.Time{}, fmt.Errorf("The credential of the ECS RAM role %s is empty.", c.EcsRoleName)
+	}
+
+	expiredAt, err := time.Parse(time.RFC3339, credential.Expiration)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Parsing the expiration %s of the ECS RAM role %s got an error: %#v", credential.Expiration, c.EcsRoleName, err)
+	}
+
+	c.AccessKey = credential.AccessKeyId
+	c.SecretKey = credential.AccessKeySecret
+	c.SecurityToken = credential.SecurityToken
+	return expiredAt, nil
+}
+
+func (c *Config) getEcsMetadata(path string) ([]byte, error) {
+	endpoint := c.EcsMetadataEndpoint
+	if endpoint == "" {
+		endpoint = DefaultEcsMetadataEndpoint
+	}
+	url := strings.TrimSuffix(endpoint, "/") + "/" + strings.TrimPrefix(path, "/")
+
+	httpClient := &http.Client{Timeout: ecsMetadataTimeout}
+	response, err := httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("Requesting the ECS metadata %s got an error: %#v", url, err)
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Reading the ECS metadata %s got an error: %#v", url, err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Requesting the ECS metadata %s got an unexpected status %d: %s", url, response.StatusCode, string(body))
+	}
+	return body, nil
+}
@@ -0,0 +1,211 @@
+package connectivity
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testSharedCredentials = `{
+	"current": "dev",
+	"profiles": [
+		{
+			"name": "dev",
+			"mode": "AK",
+			"access_key_id": "profile-ak",
+			"access_key_secret": "profile-sk",
+			"region_id": "cn-hangzhou"
+		},
+		{
+			"name": "sts",
+			"mode": "StsToken",
+			"access_key_id": "sts-ak",
+			"access_key_secret": "sts-sk",
+			"sts_token": "sts-token"
+		},
+		{
+			"name": "role",
+			"mode": "RamRoleArn",
+			"access_key_id": "role-ak",
+			"access_key_secret": "role-sk",
+			"ram_role_arn": "acs:ram::123456:role/test",
+			"ram_session_name": "profile-session",
+			"expired_seconds": 900
+		},
+		{
+			"name": "ecs",
+			"mode": "EcsRamRole",
+			"ram_role_name": "profile-ecs-role"
+		}
+	]
+}`
+
+// testCredentialEnv clears the credential environment variables during a test and restores them afterwards.
+func testCredentialEnv(t *testing.T, values map[string]string) func() {
+	keys := []string{"ALICLOUD_ACCESS_KEY", "ALICLOUD_SECRET_KEY", "ALICLOUD_SECURITY_TOKEN"}
+	origin := make(map[string]string)
+	for _, k := range keys {
+		origin[k] = os.Getenv(k)
+		os.Setenv(k, values[k])
+	}
+	return func() {
+		for k, v := range origin {
+			os.Setenv(k, v)
+		}
+	}
+}
+
+func testSharedCredentialsFile(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "tf-alicloud-credential")
+	if err != nil {
+		t.Fatalf("creating temp dir got an error: %#v", err)
+	}
+	path := filepath.Join(dir, "config.json")
+	if err := ioutil.WriteFile(path, []byte(testSharedCredentials), 0600); err != nil {
+		t.Fatalf("writing shared credentials file got an error: %#v", err)
+	}
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func testEcsMetadataServer(roleName string, expiration time.Time) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ram/security-credentials/":
+			if roleName == "" {
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprint(w, roleName)
+		case "/ram/security-credentials/" + roleName:
+			fmt.Fprintf(w, `{"Code":"Success","AccessKeyId":"ecs-ak","AccessKeySecret":"ecs-sk","SecurityToken":"ecs-token","Expiration":"%s"}`,
+				expiration.UTC().Format(time.RFC3339))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestConfigLoadCredential_static(t *testing.T) {
+	defer testCredentialEnv(t, map[string]string{"ALICLOUD_ACCESS_KEY": "env-ak", "ALICLOUD_SECRET_KEY": "env-sk"})()
+
+	c := &Config{AccessKey: "static-ak", SecretKey: "static-sk", SharedCredentialsFile: "/not/exist/config.json"}
+	if err := c.loadCredential(); err != nil {
+		t.Fatalf("loading credential got an error: %#v", err)
+	}
+	if c.AccessKey != "static-ak" || c.SecretKey != "static-sk" {
+		t.Fatalf("expected the static credential, got %s/%s", c.AccessKey, c.SecretKey)
+	}
+}
+
+func TestConfigLoadCredential_env(t *testing.T) {
+	defer testCredentialEnv(t, map[string]string{"ALICLOUD_ACCESS_KEY": "env-ak", "ALICLOUD_SECRET_KEY": "env-sk", "ALICLOUD_SECURITY_TOKEN": "env-token"})()
+	path, clean := testSharedCredentialsFile(t)
+	defer clean()
+
+	c := &Config{SharedCredentialsFile: path}
+	if err := c.loadCredential(); err != nil {
+		t.Fatalf("loading credential got an error: %#v", err)
+	}
+	if c.AccessKey != "env-ak" || c.SecretKey != "env-sk" || c.SecurityToken != "env-token" {
+		t.Fatalf("expected the credential of the environment variables, got %s/%s/%s", c.AccessKey, c.SecretKey, c.SecurityToken)
+	}
+}
+
+func TestConfigLoadCredential_profile(t *testing.T) {
+	defer testCredentialEnv(t, nil)()
+	path, clean := testSharedCredentialsFile(t)
+	defer clean()
+
+	c := &Config{SharedCredentialsFile: path}
+	if err := c.loadCredential(); err != nil {
+		t.Fatalf("loading credential got an error: %#v", err)
+	}
+	if c.AccessKey != "profile-ak" || c.SecretKey != "profile-sk" {
+		t.Fatalf("expected the credential of the current profile, got %s/%s", c.AccessKey, c.SecretKey)
+	}
+	if c.RegionId != "cn-hangzhou" {
+		t.Fatalf("expected the region of the current profile, got %s", c.RegionId)
+	}
+
+	c = &Config{SharedCredentialsFile: path, Profile: "sts", RegionId: "cn-beijing"}
+	if err := c.loadCredential(); err != nil {
+		t.Fatalf("loading credential got an error: %#v", err)
+	}
+	if c.AccessKey != "sts-ak" || c.SecurityToken != "sts-token" {
+		t.Fatalf("expected the credential of the sts profile, got %s/%s", c.AccessKey, c.SecurityToken)
+	}
+	if c.RegionId != "cn-beijing" {
+		t.Fatalf("expected the region of the provider wins, got %s", c.RegionId)
+	}
+
+	c = &Config{SharedCredentialsFile: path, Profile: "role"}
+	if err := c.loadCredential(); err != nil {
+		t.Fatalf("loading credential got an error: %#v", err)
+	}
+	if c.AccessKey != "role-ak" || c.RamRoleArn != "acs:ram::123456:role/test" || c.RamRoleSessionName != "profile-session" || c.RamRoleSessionExpiration != 900 {
+		t.Fatalf("expected the role of the profile, got %#v", c)
+	}
+
+	c = &Config{SharedCredentialsFile: path, Profile: "role", RamRoleArn: "acs:ram::123456:role/provider"}
+	if err := c.loadCredential(); err != nil {
+		t.Fatalf("loading credential got an error: %#v", err)
+	}
+	if c.RamRoleArn != "acs:ram::123456:role/provider" {
+		t.Fatalf("expected the assume_role of the provider wins, got %s", c.RamRoleArn)
+	}
+
+	c = &Config{SharedCredentialsFile: path, Profile: "ecs"}
+	if err := c.loadCredential(); err != nil {
+		t.Fatalf("loading credential got an error: %#v", err)
+	}
+	if c.EcsRoleName != "profile-ecs-role" || c.AccessKey != "" {
+		t.Fatalf("expected the ECS role of the profile, got %#v", c)
+	}
+
+	c = &Config{SharedCredentialsFile: path, Profile: "missing"}
+	if err := c.loadCredential(); err == nil {
+		t.Fatalf("expected an error for a missing profile")
+	}
+}
+
+func TestConfigLoadCredential_ecsRole(t *testing.T) {
+	defer testCredentialEnv(t, nil)()
+	expiration := time.Now().Add(time.Hour)
+	server := testEcsMetadataServer("ci-runner", expiration)
+	defer server.Close()
+
+	c := &Config{SharedCredentialsFile: "/not/exist/config.json", EcsMetadataEndpoint: server.URL}
+	if err := c.loadCredential(); err != nil {
+		t.Fatalf("loading credential got an error: %#v", err)
+	}
+	if c.EcsRoleName != "ci-runner" {
+		t.Fatalf("expected the role attached to the instance, got %s", c.EcsRoleName)
+	}
+
+	expiredAt, err := c.loadEcsRoleCredential()
+	if err != nil {
+		t.Fatalf("loading the ECS role credential got an error: %#v", err)
+	}
+	if c.AccessKey != "ecs-ak" || c.SecretKey != "ecs-sk" || c.SecurityToken != "ecs-token" {
+		t.Fatalf("expected the credential of the ECS role, got %s/%s/%s", c.AccessKey, c.SecretKey, c.SecurityToken)
+	}
+	if expiredAt.Unix() != expiration.Unix() {
+		t.Fatalf("expected the credential expires at %s, got %s", expiration, expiredAt)
+	}
+}
+
+func TestConfigLoadCredential_none(t *testing.T) {
+	defer testCredentialEnv(t, nil)()
+	server := testEcsMetadataServer("", time.Now())
+	defer server.Close()
+
+	c := &Config{SharedCredentialsFile: "/not/exist/config.json", EcsMetadataEndpoint: server.URL}
+	if err := c.loadCredential(); err == nil {
+		t.Fatalf("expected an error when there is no valid credential source")
+	}
+}
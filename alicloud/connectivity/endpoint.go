@@ -64,6 +64,19 @@ type Product struct {
 	DomainName  string `xml:"DomainName"`
 }
 
+// loadEndpoint returns the self-defined endpoint of a service. The endpoints are loaded in the following order,
+// and the first one found wins:
+//   1. the nested endpoints block of the provider;
+//   2. the environment variable <CODE>_ENDPOINT, like ECS_ENDPOINT;
+//   3. the file endpoints.xml in the current path, or the file set by the environment variable TF_ENDPOINT_PATH.
+// An empty string is returned when none of them is set, and the default endpoint of the service will be used.
+func (c *Config) loadEndpoint(serviceCode ServiceCode) string {
+	if endpoint := strings.TrimSpace(c.Endpoints[serviceCode]); endpoint != "" {
+		return endpoint
+	}
+	return loadEndpoint(c.RegionId, serviceCode)
+}
+
 func loadEndpoint(region string, serviceCode ServiceCode) string {
 	endpoint := strings.TrimSpace(os.Getenv(fmt.Sprintf("%s_ENDPOINT", string(serviceCode))))
 	if endpoint != "" {
@@ -0,0 +1,126 @@
+package connectivity
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// DefaultApiRateLimits are the default maximum queries per second sent to each product, which are below
+// the API quotas of a common account, so that the parallel operations of an apply are not throttled.
+var DefaultApiRateLimits = map[ServiceCode]int{
+	ECSCode:      20,
+	ESSCode:      10,
+	RAMCode:      10,
+	VPCCode:      20,
+	SLBCode:      20,
+	RDSCode:      20,
+	OSSCode:      50,
+	CONTAINCode:  5,
+	CDNCode:      10,
+	CMSCode:      10,
+	KMSCode:      20,
+	OTSCode:      10,
+	DNSCode:      10,
+	PVTZCode:     10,
+	LOGCode:      20,
+	FCCode:       20,
+	DDSCode:      10,
+	STSCode:      10,
+	CENCode:      10,
+	KVSTORECode:  10,
+	DATAHUBCode:  20,
+	MNSCode:      20,
+	CLOUDAPICode: 10,
+	DRDSCode:     5,
+}
+
+// RateLimiter is a token bucket which allows rate requests per second on average, and bursts of
+// up to burst requests.
+type RateLimiter struct {
+	rate  float64
+	burst float64
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+
+	// now and sleep can be replaced in tests.
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func NewRateLimiter(rate, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   float64(rate),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+		sleep:  time.Sleep,
+	}
+}
+
+// Wait blocks until a request is allowed. The token of a request which has to wait is reserved at once,
+// so the concurrent requests are served in turn instead of competing for the next token.
+func (l *RateLimiter) Wait() time.Duration {
+	delay := l.reserve()
+	if delay > 0 {
+		l.sleep(delay)
+	}
+	return delay
+}
+
+func (l *RateLimiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(time.Second) / l.rate)
+}
+
+// rateLimiter returns the limiter of a product, or nil if the requests to the product are not limited.
+func (client *AliyunClient) rateLimiter(product ServiceCode) *RateLimiter {
+	client.rateLimitersMutex.Lock()
+	defer client.rateLimitersMutex.Unlock()
+
+	if limiter, ok := client.rateLimiters[product]; ok {
+		return limiter
+	}
+
+	rate, ok := client.getConfig().ApiRateLimits[product]
+	if !ok {
+		rate = DefaultApiRateLimits[product]
+	}
+	var limiter *RateLimiter
+	if rate > 0 {
+		limiter = NewRateLimiter(rate, rate)
+	}
+	client.rateLimiters[product] = limiter
+	return limiter
+}
+
+// waitForRateLimit blocks until the request to the product is allowed by its rate limit.
+func (client *AliyunClient) waitForRateLimit(product ServiceCode) {
+	limiter := client.rateLimiter(product)
+	if limiter == nil {
+		return
+	}
+	if delay := limiter.Wait(); delay > 0 {
+		log.Printf("[DEBUG] The request to %s has been delayed %s by the rate limit.", product, delay)
+	}
+}
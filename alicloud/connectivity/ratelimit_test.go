@@ -0,0 +1,129 @@
+package connectivity
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// testRateLimiter returns a limiter whose sleeps only move a fake clock forward.
+func testRateLimiter(rate, burst int) (*RateLimiter, func() time.Time) {
+	l := NewRateLimiter(rate, burst)
+	var mutex sync.Mutex
+	now := time.Now()
+	l.now = func() time.Time {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return now
+	}
+	l.sleep = func(d time.Duration) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if t := now.Add(d); t.After(now) {
+			now = t
+		}
+	}
+	return l, l.now
+}
+
+func TestRateLimiter_burst(t *testing.T) {
+	l, now := testRateLimiter(10, 5)
+	start := now()
+
+	for i := 0; i < 5; i++ {
+		if delay := l.Wait(); delay != 0 {
+			t.Fatalf("expected request %d in the burst not to wait, got %s", i, delay)
+		}
+	}
+	if delay := l.Wait(); delay != 100*time.Millisecond {
+		t.Fatalf("expected the request after the burst to wait 100ms, got %s", delay)
+	}
+	if elapsed := now().Sub(start); elapsed != 100*time.Millisecond {
+		t.Fatalf("expected 100ms to elapse, got %s", elapsed)
+	}
+}
+
+func TestRateLimiter_throughput(t *testing.T) {
+	l, now := testRateLimiter(10, 10)
+	start := now()
+
+	for i := 0; i < 110; i++ {
+		l.Wait()
+	}
+	// The first 10 requests are the burst, and the other 100 requests are sent at 10 per second.
+	if elapsed := now().Sub(start); elapsed < 9900*time.Millisecond || elapsed > 10100*time.Millisecond {
+		t.Fatalf("expected 110 requests to take 10s, got %s", elapsed)
+	}
+}
+
+func TestRateLimiter_refill(t *testing.T) {
+	l, now := testRateLimiter(2, 2)
+	start := now()
+
+	l.Wait()
+	l.Wait()
+	// The bucket refills while it is idle, but never beyond the burst.
+	l.sleep(10 * time.Second)
+	for i := 0; i < 2; i++ {
+		if delay := l.Wait(); delay != 0 {
+			t.Fatalf("expected request %d after the refill not to wait, got %s", i, delay)
+		}
+	}
+	if delay := l.Wait(); delay != 500*time.Millisecond {
+		t.Fatalf("expected the request beyond the burst to wait 500ms, got %s", delay)
+	}
+	if elapsed := now().Sub(start); elapsed != 10500*time.Millisecond {
+		t.Fatalf("expected 10.5s to elapse, got %s", elapsed)
+	}
+}
+
+func TestRateLimiter_concurrent(t *testing.T) {
+	l, _ := testRateLimiter(10, 1)
+
+	var mutex sync.Mutex
+	delays := make(map[time.Duration]bool)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			delay := l.reserve()
+			mutex.Lock()
+			defer mutex.Unlock()
+			delays[delay] = true
+		}()
+	}
+	wg.Wait()
+
+	// Without the clock moving, every request reserves its own slot 100ms after the previous one.
+	if len(delays) != 20 {
+		t.Fatalf("expected 20 distinct reservations, got %v", delays)
+	}
+	for i := 0; i < 20; i++ {
+		if d := time.Duration(i) * 100 * time.Millisecond; !delays[d] {
+			t.Fatalf("expected a reservation of %s, got %v", d, delays)
+		}
+	}
+}
+
+func TestAliyunClient_rateLimiter(t *testing.T) {
+	client := &AliyunClient{
+		config: &Config{
+			ApiRateLimits: map[ServiceCode]int{ECSCode: 5, VPCCode: 0},
+		},
+		rateLimiters: make(map[ServiceCode]*RateLimiter),
+	}
+
+	if l := client.rateLimiter(ECSCode); l == nil || l.rate != 5 || l.burst != 5 {
+		t.Fatalf("expected the ECS limit to be overridden to 5, got %#v", l)
+	}
+	if l := client.rateLimiter(ECSCode); l != client.rateLimiter(ECSCode) {
+		t.Fatalf("expected the ECS limiter to be shared")
+	}
+	if l := client.rateLimiter(VPCCode); l != nil {
+		t.Fatalf("expected no VPC limit, got %#v", l)
+	}
+	if l := client.rateLimiter(RDSCode); l == nil || l.rate != float64(DefaultApiRateLimits[RDSCode]) {
+		t.Fatalf("expected the default RDS limit, got %#v", l)
+	}
+}
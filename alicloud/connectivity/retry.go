@@ -0,0 +1,170 @@
+package connectivity
+
+import (
+	"log"
+	"math/rand"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
+	"github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/aliyun/fc-go-sdk"
+	"github.com/denverdino/aliyungo/common"
+)
+
+const DefaultMaxRetries = 10
+const DefaultRetryBaseDelay = 1 * time.Second
+const DefaultRetryMaxDelay = 30 * time.Second
+
+// DefaultRetryMaxElapsed bounds the total time spent on retrying one API call, whatever the max retries is.
+const DefaultRetryMaxElapsed = 10 * time.Minute
+
+// ServerUnreachableErrorCode is the error code of the API calls which failed in the transport, like the network
+// failures and the timeouts.
+const ServerUnreachableErrorCode = "SDK.ServerUnreachable"
+
+// retryableErrorCodes are the error codes which mean the request has been throttled or the service is temporarily
+// unavailable. Such requests are rejected before they are processed, so that any request, even a non-idempotent one
+// creating or allocating resources, can be sent again safely.
+var retryableErrorCodes = []string{
+	"Throttling",
+	"ServiceUnavailable",
+}
+
+// RetryPolicy retries the API calls which were throttled or rejected by an unavailable service,
+// using the exponential backoff with full jitter.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	MaxElapsed time.Duration
+
+	// now, sleep and random can be replaced in tests.
+	now         func() time.Time
+	sleep       func(time.Duration)
+	randomMutex sync.Mutex
+	random      *rand.Rand
+}
+
+func NewRetryPolicy(maxRetries int, baseDelay, maxDelay, maxElapsed time.Duration) *RetryPolicy {
+	if baseDelay <= 0 {
+		baseDelay = DefaultRetryBaseDelay
+	}
+	if maxDelay < baseDelay {
+		maxDelay = baseDelay
+	}
+	return &RetryPolicy{
+		MaxRetries: maxRetries,
+		BaseDelay:  baseDelay,
+		MaxDelay:   maxDelay,
+		MaxElapsed: maxElapsed,
+		now:        time.Now,
+		sleep:      time.Sleep,
+		random:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Backoff returns the delay before the given retry, which starts from 0. The delay is a random duration
+// between 0 and min(MaxDelay, BaseDelay * 2^attempt), known as the full jitter.
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	ceiling := p.MaxDelay
+	if attempt < 62 {
+		if d := p.BaseDelay << uint(attempt); d > 0 && d < ceiling {
+			ceiling = d
+		}
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+
+	p.randomMutex.Lock()
+	defer p.randomMutex.Unlock()
+	return time.Duration(p.random.Int63n(int64(ceiling) + 1))
+}
+
+// Run invokes do until it succeeds, it returns an error which is not retryable, or the retries are used up.
+// The error of the last attempt is returned as it is, so that the callers can still check its error code.
+func (p *RetryPolicy) Run(retryable func(error) bool, do func() (interface{}, error)) (interface{}, error) {
+	start := p.now()
+	for attempt := 0; ; attempt++ {
+		raw, err := do()
+		if err == nil || !retryable(err) {
+			return raw, err
+		}
+		if attempt >= p.MaxRetries {
+			log.Printf("[WARN] Giving up the API call after %d retries, since it got an error: %s", attempt, err)
+			return raw, err
+		}
+
+		delay := p.Backoff(attempt)
+		if p.MaxElapsed > 0 {
+			remaining := p.MaxElapsed - p.now().Sub(start)
+			if remaining <= 0 {
+				log.Printf("[WARN] Giving up the API call after retrying for %s, since it got an error: %s", p.MaxElapsed, err)
+				return raw, err
+			}
+			if delay > remaining {
+				delay = remaining
+			}
+		}
+		log.Printf("[DEBUG] Retrying the API call in %s after %d attempts, since it got an error: %s", delay, attempt+1, err)
+		p.sleep(delay)
+	}
+}
+
+// IsRetryableError returns whether the error is caused by the throttling or the unavailability of the service,
+// and the request can be sent again safely. The other errors, like the 5xx errors and the timeouts, may happen
+// after the request has been processed, so they are left to the invokers of the resources, which know whether
+// the API call is idempotent.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	status, code, _ := parseError(err)
+
+	if status == 503 {
+		return true
+	}
+	for _, c := range retryableErrorCodes {
+		if code == c || strings.HasPrefix(code, c+".") {
+			return true
+		}
+	}
+	return strings.Contains(err.Error(), "Throttling")
+}
+
+// parseError returns the http status, the error code and the request id of the errors returned by the SDKs.
+func parseError(err error) (status int, code, requestId string) {
+	switch e := err.(type) {
+	case *errors.ServerError:
+		return e.HttpStatus(), e.ErrorCode(), e.RequestId()
+	case *errors.ClientError:
+		return 0, e.ErrorCode(), ""
+	case *common.Error:
+		return e.StatusCode, e.Code, e.RequestId
+	case *sls.Error:
+		return int(e.HTTPCode), e.Code, e.RequestID
+	case oss.ServiceError:
+		return e.StatusCode, e.Code, e.RequestID
+	case *fc.ServiceError:
+		return e.HTTPStatus, e.ErrorCode, e.RequestID
+	case datahub.DatahubError:
+		return e.StatusCode, e.Code, e.RequestId
+	}
+	return 0, "", ""
+}
+
+// retry runs the API call with the retry policy of the provider. The transport errors, which have no error code,
+// are returned as the client errors with ServerUnreachableErrorCode, so that the invokers can catch them.
+func (client *AliyunClient) retry(do func() (interface{}, error)) (interface{}, error) {
+	raw, err := client.retryPolicy.Run(IsRetryableError, do)
+	if e, ok := err.(net.Error); ok {
+		err = errors.NewClientError(ServerUnreachableErrorCode, e.Error(), e)
+	}
+	return raw, err
+}
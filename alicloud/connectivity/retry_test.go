@@ -0,0 +1,147 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/denverdino/aliyungo/common"
+)
+
+// testRetryPolicy returns a policy whose sleeps only move a fake clock forward.
+func testRetryPolicy(maxRetries int, baseDelay, maxDelay, maxElapsed time.Duration) (*RetryPolicy, *[]time.Duration) {
+	p := NewRetryPolicy(maxRetries, baseDelay, maxDelay, maxElapsed)
+	now := time.Now()
+	sleeps := &[]time.Duration{}
+	p.now = func() time.Time { return now }
+	p.sleep = func(d time.Duration) {
+		*sleeps = append(*sleeps, d)
+		now = now.Add(d)
+	}
+	return p, sleeps
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := NewRetryPolicy(10, time.Second, 30*time.Second, 0)
+
+	for attempt := 0; attempt < 20; attempt++ {
+		ceiling := time.Second << uint(attempt)
+		if ceiling > 30*time.Second {
+			ceiling = 30 * time.Second
+		}
+		distinct := make(map[time.Duration]bool)
+		for i := 0; i < 100; i++ {
+			d := p.Backoff(attempt)
+			if d < 0 || d > ceiling {
+				t.Fatalf("expected the backoff of attempt %d in [0, %s], got %s", attempt, ceiling, d)
+			}
+			distinct[d] = true
+		}
+		if len(distinct) < 2 {
+			t.Fatalf("expected the backoff of attempt %d to be jittered, got %v", attempt, distinct)
+		}
+	}
+}
+
+func TestRetryPolicy_run(t *testing.T) {
+	throttling := errors.NewServerError(400, `{"Code":"Throttling","Message":"Request was denied due to request throttling."}`, "")
+
+	p, sleeps := testRetryPolicy(3, time.Second, 10*time.Second, 0)
+	calls := 0
+	raw, err := p.Run(IsRetryableError, func() (interface{}, error) {
+		calls++
+		if calls < 3 {
+			return nil, throttling
+		}
+		return "ok", nil
+	})
+	if err != nil || raw != "ok" || calls != 3 || len(*sleeps) != 2 {
+		t.Fatalf("expected success after 2 retries, got %v, %#v with %d calls and %d sleeps", raw, err, calls, len(*sleeps))
+	}
+
+	p, sleeps = testRetryPolicy(3, time.Second, 10*time.Second, 0)
+	calls = 0
+	_, err = p.Run(IsRetryableError, func() (interface{}, error) {
+		calls++
+		return nil, throttling
+	})
+	if err != throttling || calls != 4 || len(*sleeps) != 3 {
+		t.Fatalf("expected to give up after 3 retries, got %#v with %d calls and %d sleeps", err, calls, len(*sleeps))
+	}
+
+	p, sleeps = testRetryPolicy(3, time.Second, 10*time.Second, 0)
+	calls = 0
+	notFound := fmt.Errorf("InvalidVpcId.NotFound")
+	_, err = p.Run(IsRetryableError, func() (interface{}, error) {
+		calls++
+		return nil, notFound
+	})
+	if err != notFound || calls != 1 || len(*sleeps) != 0 {
+		t.Fatalf("expected no retry for a client error, got %#v with %d calls", err, calls)
+	}
+}
+
+func TestRetryPolicy_maxElapsed(t *testing.T) {
+	p, sleeps := testRetryPolicy(100, 10*time.Second, time.Minute, 2*time.Minute)
+	calls := 0
+	_, err := p.Run(IsRetryableError, func() (interface{}, error) {
+		calls++
+		return nil, &common.Error{StatusCode: 503}
+	})
+	if err == nil || calls >= 100 {
+		t.Fatalf("expected to give up before the max retries, got %#v with %d calls", err, calls)
+	}
+
+	var elapsed time.Duration
+	for _, d := range *sleeps {
+		elapsed += d
+	}
+	if elapsed > 2*time.Minute {
+		t.Fatalf("expected the retries to stop within 2m, got %s", elapsed)
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	cases := []struct {
+		err       error
+		retryable bool
+	}{
+		{nil, false},
+		{errors.NewServerError(400, `{"Code":"Throttling.User"}`, ""), true},
+		{errors.NewServerError(503, `{"Code":"ServiceUnavailable"}`, ""), true},
+		{errors.NewServerError(500, `{"Code":"UnknownError"}`, ""), false},
+		{errors.NewServerError(404, `{"Code":"InvalidInstanceId.NotFound"}`, ""), false},
+		{errors.NewClientError("SDK.ServerUnreachable", "dial tcp: i/o timeout", nil), false},
+		{errors.NewClientError(errors.TimeoutErrorCode, "read tcp: i/o timeout", nil), false},
+		{errors.NewClientError(errors.InvalidParamErrorCode, "invalid", nil), false},
+		{&common.Error{ErrorResponse: common.ErrorResponse{Code: "AliyunGoClientFailure"}, StatusCode: -1}, false},
+		{&common.Error{ErrorResponse: common.ErrorResponse{Code: "InvalidParameter"}, StatusCode: 400}, false},
+		{&common.Error{ErrorResponse: common.ErrorResponse{Code: "ServiceUnavailable"}, StatusCode: 503}, true},
+		{oss.ServiceError{Code: "InternalError", StatusCode: 500}, false},
+		{oss.ServiceError{Code: "NoSuchBucket", StatusCode: 404}, false},
+		{fmt.Errorf("Throttling.Api: Request was denied"), true},
+	}
+	for i, c := range cases {
+		if got := IsRetryableError(c.err); got != c.retryable {
+			t.Errorf("case %d: expected %t for %#v, got %t", i, c.retryable, c.err, got)
+		}
+	}
+}
+
+func TestAliyunClient_retryTransportError(t *testing.T) {
+	client, _ := testTracedClient()
+
+	calls := 0
+	transportErr := &url.Error{Op: "Post", URL: "https://ecs.aliyuncs.com", Err: fmt.Errorf("dial tcp: i/o timeout")}
+	_, err := client.retry(func() (interface{}, error) {
+		calls++
+		return nil, transportErr
+	})
+	e, ok := err.(*errors.ClientError)
+	if !ok || e.ErrorCode() != ServerUnreachableErrorCode || e.OriginError() != transportErr || calls != 1 {
+		t.Fatalf("expected a %s client error without retries, got %#v with %d calls", ServerUnreachableErrorCode, err, calls)
+	}
+}
@@ -0,0 +1,200 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ApiTrace records one API call made through the With*Client methods, including all of its retries.
+type ApiTrace struct {
+	Time      time.Time `json:"time"`
+	Product   string    `json:"product"`
+	Action    string    `json:"action,omitempty"`
+	Caller    string    `json:"caller,omitempty"`
+	RequestId string    `json:"request_id,omitempty"`
+	Duration  float64   `json:"duration_ms"`
+	Retries   int       `json:"retries"`
+	ErrorCode string    `json:"error_code,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// ApiTracer receives the trace of every API call. It is called concurrently by the resources.
+type ApiTracer interface {
+	TraceApiCall(trace *ApiTrace)
+}
+
+// logApiTracer writes the traces to the Terraform log, which is only shown when TF_LOG is DEBUG or TRACE.
+type logApiTracer struct{}
+
+func (logApiTracer) TraceApiCall(trace *ApiTrace) {
+	data, err := json.Marshal(trace)
+	if err != nil {
+		log.Printf("[WARN] Marshaling the API trace got an error: %#v", err)
+		return
+	}
+	log.Printf("[DEBUG] API trace: %s", data)
+}
+
+// fileApiTracer appends the traces to a file as JSON lines.
+type fileApiTracer struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+func NewFileApiTracer(path string) (ApiTracer, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("Opening the API trace file %s got an error: %#v", path, err)
+	}
+	return &fileApiTracer{writer: file}, nil
+}
+
+func (t *fileApiTracer) TraceApiCall(trace *ApiTrace) {
+	data, err := json.Marshal(trace)
+	if err != nil {
+		log.Printf("[WARN] Marshaling the API trace got an error: %#v", err)
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if _, err := t.writer.Write(append(data, '\n')); err != nil {
+		log.Printf("[WARN] Writing the API trace got an error: %#v", err)
+	}
+}
+
+// secretPattern matches the values of the sensitive parameters in query strings, JSON and XML bodies.
+var secretPattern = regexp.MustCompile(`(?i)((?:AccessKeyId|AccessKeySecret|SecurityToken|Signature|Password|SecretKey|Secret)` +
+	`(?:"?\s*[:=]\s*"?|>))([^"&,\s<]+)`)
+
+// redactSecrets hides the credential of the client and the values of the sensitive parameters in the message.
+func (client *AliyunClient) redactSecrets(message string) string {
+	config := client.getConfig()
+	for _, secret := range []string{config.AccessKey, config.SecretKey, config.SecurityToken} {
+		if secret != "" {
+			message = strings.Replace(message, secret, "******", -1)
+		}
+	}
+	return secretPattern.ReplaceAllString(message, "${1}******")
+}
+
+// invoke runs the API call with the rate limit and the retry policy of the provider, and traces it.
+func (client *AliyunClient) invoke(product ServiceCode, do func() (interface{}, error)) (interface{}, error) {
+	trace := &ApiTrace{
+		Time:    time.Now(),
+		Product: string(product),
+		Caller:  apiCaller(),
+	}
+
+	attempts := 0
+	var lastErr error
+	raw, err := client.retry(func() (interface{}, error) {
+		attempts++
+		client.waitForRateLimit(product)
+		raw, err := do()
+		lastErr = err
+		return raw, err
+	})
+
+	trace.Duration = float64(time.Since(trace.Time)) / float64(time.Millisecond)
+	trace.Retries = attempts - 1
+	trace.Action = apiAction(raw)
+	trace.RequestId = apiRequestId(raw)
+	if err != nil {
+		_, code, requestId := parseError(lastErr)
+		trace.ErrorCode = code
+		if requestId != "" {
+			trace.RequestId = requestId
+		}
+		trace.Error = client.redactSecrets(err.Error())
+	}
+	client.apiTracer.TraceApiCall(trace)
+
+	return raw, err
+}
+
+// apiCaller returns the first function on the stack which is not a method of AliyunClient, that is the resource
+// or the service making the API call.
+func apiCaller() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !strings.Contains(frame.Function, "/alicloud/connectivity.(*AliyunClient).") {
+			return frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// apiAction returns the API action of the response of the official Go SDK. The common requests made by
+// NewCommonRequest are recognized by the action of their http request.
+func apiAction(raw interface{}) string {
+	if r, ok := raw.(interface {
+		GetOriginHttpResponse() *http.Response
+	}); ok && !isNil(raw) {
+		if resp := r.GetOriginHttpResponse(); resp != nil && resp.Request != nil {
+			if action := resp.Request.URL.Query().Get("Action"); action != "" {
+				return action
+			}
+		}
+	}
+
+	t := reflect.TypeOf(raw)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || !strings.HasSuffix(t.Name(), "Response") || t.Name() == "CommonResponse" {
+		return ""
+	}
+	return strings.TrimSuffix(t.Name(), "Response")
+}
+
+// apiRequestId returns the request id of the response, if it has.
+func apiRequestId(raw interface{}) string {
+	if isNil(raw) {
+		return ""
+	}
+	if r, ok := raw.(interface {
+		GetHttpHeaders() map[string][]string
+	}); ok {
+		if ids := http.Header(r.GetHttpHeaders())["X-Acs-Request-Id"]; len(ids) > 0 {
+			return ids[0]
+		}
+	}
+
+	v := reflect.ValueOf(raw)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	if f := v.FieldByName("RequestId"); f.IsValid() && f.Kind() == reflect.String {
+		return f.String()
+	}
+	return ""
+}
+
+func isNil(raw interface{}) bool {
+	if raw == nil {
+		return true
+	}
+	v := reflect.ValueOf(raw)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
@@ -0,0 +1,130 @@
+package connectivity
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/denverdino/aliyungo/common"
+)
+
+type testApiTracer struct {
+	mutex  sync.Mutex
+	traces []*ApiTrace
+}
+
+func (t *testApiTracer) TraceApiCall(trace *ApiTrace) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.traces = append(t.traces, trace)
+}
+
+func testTracedClient() (*AliyunClient, *testApiTracer) {
+	p, _ := testRetryPolicy(3, time.Second, 10*time.Second, 0)
+	tracer := &testApiTracer{}
+	return &AliyunClient{
+		config:       &Config{AccessKey: "testAccessKey", SecretKey: "testSecretKey"},
+		retryPolicy:  p,
+		apiTracer:    tracer,
+		rateLimiters: make(map[ServiceCode]*RateLimiter),
+	}, tracer
+}
+
+func TestAliyunClient_invoke(t *testing.T) {
+	client, tracer := testTracedClient()
+
+	calls := 0
+	_, err := client.invoke(ECSCode, func() (interface{}, error) {
+		calls++
+		if calls < 3 {
+			return ecs.CreateDescribeInstancesResponse(), errors.NewServerError(400, `{"Code":"Throttling","RequestId":"req-1"}`, "")
+		}
+		return ecs.CreateDescribeInstancesResponse(), nil
+	})
+	if err != nil {
+		t.Fatalf("invoking got an error: %#v", err)
+	}
+
+	_, err = client.invoke(RAMCode, func() (interface{}, error) {
+		return nil, &common.Error{
+			ErrorResponse: common.ErrorResponse{Response: common.Response{RequestId: "req-2"}, Code: "EntityNotExist.User"},
+			StatusCode:    404,
+		}
+	})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	if len(tracer.traces) != 2 {
+		t.Fatalf("expected 2 traces, got %d", len(tracer.traces))
+	}
+	trace := tracer.traces[0]
+	if trace.Product != "ECS" || trace.Action != "DescribeInstances" || trace.Retries != 2 || trace.ErrorCode != "" || trace.Error != "" {
+		t.Fatalf("unexpected trace of a retried call: %#v", trace)
+	}
+	if !strings.HasSuffix(trace.Caller, "TestAliyunClient_invoke") {
+		t.Fatalf("expected the caller to be the test, got %s", trace.Caller)
+	}
+	trace = tracer.traces[1]
+	if trace.Product != "RAM" || trace.Action != "" || trace.Retries != 0 || trace.ErrorCode != "EntityNotExist.User" || trace.RequestId != "req-2" {
+		t.Fatalf("unexpected trace of a failed call: %#v", trace)
+	}
+}
+
+func TestAliyunClient_redactSecrets(t *testing.T) {
+	client, _ := testTracedClient()
+
+	message := client.redactSecrets(`https://ecs.aliyuncs.com/?AccessKeyId=testAccessKey&Action=DescribeInstances&Signature=abc%3D&SecurityToken=token ` +
+		`{"Password":"p@ss","SecretKey":"testSecretKey"} <AccessKeySecret>secret</AccessKeySecret>`)
+	for _, secret := range []string{"testAccessKey", "testSecretKey", "abc%3D", "token", "p@ss", ">secret<"} {
+		if strings.Contains(message, secret) {
+			t.Fatalf("expected %s to be redacted, got %s", secret, message)
+		}
+	}
+	if !strings.Contains(message, "Action=DescribeInstances") {
+		t.Fatalf("expected the action to be kept, got %s", message)
+	}
+}
+
+func TestFileApiTracer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-api-trace")
+	if err != nil {
+		t.Fatalf("creating the temporary directory got an error: %#v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "trace.jsonl")
+	tracer, err := NewFileApiTracer(path)
+	if err != nil {
+		t.Fatalf("creating the tracer got an error: %#v", err)
+	}
+	tracer.TraceApiCall(&ApiTrace{Product: "ECS", Action: "DescribeInstances", RequestId: "req-1"})
+	tracer.TraceApiCall(&ApiTrace{Product: "VPC", Action: "DescribeVpcs", Retries: 1, ErrorCode: "Throttling"})
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening the trace file got an error: %#v", err)
+	}
+	defer file.Close()
+
+	var traces []ApiTrace
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var trace ApiTrace
+		if err := json.Unmarshal(scanner.Bytes(), &trace); err != nil {
+			t.Fatalf("parsing the trace %s got an error: %#v", scanner.Text(), err)
+		}
+		traces = append(traces, trace)
+	}
+	if len(traces) != 2 || traces[0].RequestId != "req-1" || traces[1].ErrorCode != "Throttling" || traces[1].Retries != 1 {
+		t.Fatalf("unexpected traces: %#v", traces)
+	}
+}
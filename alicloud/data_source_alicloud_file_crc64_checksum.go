@@ -0,0 +1,45 @@
+package alicloud
+
+import (
+	"fmt"
+	"hash/crc64"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudFileCRC64Checksum() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudFileCRC64ChecksumRead,
+
+		Schema: map[string]*schema.Schema{
+			"filename": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed values
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudFileCRC64ChecksumRead(d *schema.ResourceData, meta interface{}) error {
+	filename := d.Get("filename").(string)
+	content, err := loadFileContent(filename)
+	if err != nil {
+		return fmt.Errorf("Unable to load %q: %s", filename, err)
+	}
+
+	d.SetId(filename)
+	d.Set("checksum", computeCRC64Checksum(content))
+	return nil
+}
+
+// computeCRC64Checksum returns the CRC64 (ECMA) checksum in decimal, which is the format of the code checksum of FC functions.
+func computeCRC64Checksum(content []byte) string {
+	return strconv.FormatUint(crc64.Checksum(content, crc64.MakeTable(crc64.ECMA)), 10)
+}
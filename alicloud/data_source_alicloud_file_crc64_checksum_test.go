@@ -0,0 +1,42 @@
+package alicloud
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudFileCRC64ChecksumDataSource_basic(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-file-crc64-checksum-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if err := ioutil.WriteFile(tmpFile.Name(), []byte("123456789"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "alicloud_file_crc64_checksum" "default" {
+	filename = "%s"
+}
+`, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_file_crc64_checksum.default"),
+					// the check value of CRC-64/XZ, which is CRC64 with the ECMA polynomial
+					resource.TestCheckResourceAttr("data.alicloud_file_crc64_checksum.default", "checksum", "11051210869376104954"),
+				),
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package alicloud
 import (
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -375,14 +376,16 @@ func bucketsDescriptionAttributes(d *schema.ResourceData, buckets []oss.BucketPr
 					}
 
 					// Expiration
-					expirationMapping := make(map[string]interface{})
-					if !lifecycleRule.Expiration.Date.IsZero() {
-						expirationMapping["date"] = (lifecycleRule.Expiration.Date).Format("2006-01-02")
-					}
-					if &lifecycleRule.Expiration.Days != nil {
+					if lifecycleRule.Expiration != nil {
+						expirationMapping := make(map[string]interface{})
+						if lifecycleRule.Expiration.Date != "" {
+							if t, err := time.Parse(time.RFC3339, lifecycleRule.Expiration.Date); err == nil {
+								expirationMapping["date"] = t.Format("2006-01-02")
+							}
+						}
 						expirationMapping["days"] = int(lifecycleRule.Expiration.Days)
+						ruleMapping["expiration"] = []map[string]interface{}{expirationMapping}
 					}
-					ruleMapping["expiration"] = []map[string]interface{}{expirationMapping}
 					lifecycleRuleMappings = append(lifecycleRuleMappings, ruleMapping)
 				}
 			}
@@ -0,0 +1,70 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudSnapshotsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudSnapshotsDataSourceConfig(EcsInstanceCommonTestCase),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_snapshots.snapshots"),
+					resource.TestCheckResourceAttr("data.alicloud_snapshots.snapshots", "snapshots.#", "1"),
+					resource.TestCheckResourceAttr("data.alicloud_snapshots.snapshots", "snapshots.0.name", "tf-testAccSnapshotConfig"),
+					resource.TestCheckResourceAttr("data.alicloud_snapshots.snapshots", "snapshots.0.description", "Hello ecs snapshot."),
+					resource.TestCheckResourceAttr("data.alicloud_snapshots.snapshots", "snapshots.0.status", "accomplished"),
+					resource.TestCheckResourceAttr("data.alicloud_snapshots.snapshots", "snapshots.0.source_disk_type", "Data"),
+					resource.TestCheckResourceAttr("data.alicloud_snapshots.snapshots", "snapshots.0.tags.%", "1"),
+					resource.TestCheckResourceAttrSet("data.alicloud_snapshots.snapshots", "snapshots.0.id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_snapshots.snapshots", "snapshots.0.source_disk_id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_snapshots.snapshots", "snapshots.0.creation_time"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudSnapshotsDataSource_empty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudSnapshotsDataSourceEmpty,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_snapshots.snapshots"),
+					resource.TestCheckResourceAttr("data.alicloud_snapshots.snapshots", "snapshots.#", "0"),
+					resource.TestCheckNoResourceAttr("data.alicloud_snapshots.snapshots", "snapshots.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlicloudSnapshotsDataSourceConfig(common string) string {
+	return fmt.Sprintf(`
+	%s
+
+	data "alicloud_snapshots" "snapshots" {
+	  ids = ["${alicloud_snapshot.snapshot.id}"]
+	  name_regex = "tf-testAccSnapshotConfig"
+	}
+	`, testAccSnapshotConfig(common, "tf-testAccSnapshotConfig"))
+}
+
+const testAccCheckAlicloudSnapshotsDataSourceEmpty = `
+data "alicloud_snapshots" "snapshots" {
+	name_regex = "^tf-testacc-fake-name"
+}
+`
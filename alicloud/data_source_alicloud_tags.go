@@ -0,0 +1,171 @@
+package alicloud
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// taggedResourceType describes how the resources of a Terraform resource type are found by tags and named in ARNs.
+type taggedResourceType struct {
+	service      func(client *connectivity.AliyunClient) TaggedResourcesService
+	resourceType TagResourceType
+	arnService   string
+	arnType      string
+}
+
+func ecsTaggedResources(client *connectivity.AliyunClient) TaggedResourcesService {
+	return &EcsService{client}
+}
+
+func vpcTaggedResources(client *connectivity.AliyunClient) TaggedResourcesService {
+	return &VpcService{client}
+}
+
+func slbTaggedResources(client *connectivity.AliyunClient) TaggedResourcesService {
+	return &SlbService{client}
+}
+
+func rdsTaggedResources(client *connectivity.AliyunClient) TaggedResourcesService {
+	return &RdsService{client}
+}
+
+var taggedResourceTypes = map[string]taggedResourceType{
+	"alicloud_instance":       {ecsTaggedResources, TagResourceInstance, "ecs", "instance"},
+	"alicloud_disk":           {ecsTaggedResources, TagResourceDisk, "ecs", "disk"},
+	"alicloud_snapshot":       {ecsTaggedResources, TagResourceSnapshot, "ecs", "snapshot"},
+	"alicloud_image":          {ecsTaggedResources, TagResourceImage, "ecs", "image"},
+	"alicloud_security_group": {ecsTaggedResources, TagResourceSecurityGroup, "ecs", "securitygroup"},
+	"alicloud_vpc":            {vpcTaggedResources, TagResourceVpc, "vpc", "vpc"},
+	"alicloud_vswitch":        {vpcTaggedResources, TagResourceVSwitch, "vpc", "vswitch"},
+	"alicloud_eip":            {vpcTaggedResources, TagResourceEip, "vpc", "eip"},
+	"alicloud_nat_gateway":    {vpcTaggedResources, TagResourceNatGateway, "vpc", "natgateway"},
+	"alicloud_slb":            {slbTaggedResources, TagResourceLoadBalancer, "slb", "loadbalancer"},
+	"alicloud_db_instance":    {rdsTaggedResources, TagResourceDBInstance, "rds", "dbinstance"},
+}
+
+func taggedResourceTypeNames() []string {
+	var names []string
+	for name := range taggedResourceTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func dataSourceAlicloudTags() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudTagsRead,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAllowedStringValue(taggedResourceTypeNames()),
+				},
+				MinItems: 1,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudTagsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	tags := make(map[string]string)
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		tags[k] = v.(string)
+	}
+	if len(tags) < 1 {
+		return fmt.Errorf("At least one tag must be set to find the tagged resources.")
+	}
+
+	names := taggedResourceTypeNames()
+	if v, ok := d.GetOk("resource_types"); ok {
+		names = expandStringList(v.([]interface{}))
+	}
+
+	accountId, err := client.AccountId()
+	if err != nil {
+		return fmt.Errorf("Getting the account id got an error: %#v", err)
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, name := range names {
+		t := taggedResourceTypes[name]
+		resourceIds, err := t.service(client).DescribeResourcesByTags(t.resourceType, tags)
+		if err != nil {
+			return fmt.Errorf("Describing the %s resources by tags got an error: %#v", name, err)
+		}
+		for _, id := range resourceIds {
+			mapping := map[string]interface{}{
+				"id":        id,
+				"type":      name,
+				"arn":       fmt.Sprintf("acs:%s:%s:%s:%s/%s", t.arnService, client.RegionId, accountId, t.arnType, id),
+				"region_id": client.RegionId,
+			}
+			ids = append(ids, id)
+			s = append(s, mapping)
+		}
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("resources", s); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package alicloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudTagsDataSource_vpc(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudTagsDataSourceVpcConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_tags.tagged"),
+					resource.TestCheckResourceAttr("data.alicloud_tags.tagged", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.alicloud_tags.tagged", "resources.#", "2"),
+					resource.TestCheckResourceAttrSet("data.alicloud_tags.tagged", "resources.0.id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_tags.tagged", "resources.0.region_id"),
+					resource.TestMatchResourceAttr("data.alicloud_tags.tagged", "resources.0.type", regexp.MustCompile("^alicloud_(vpc|vswitch)$")),
+					resource.TestMatchResourceAttr("data.alicloud_tags.tagged", "resources.0.arn", regexp.MustCompile("^acs:vpc:[a-z0-9-]+:[0-9]+:(vpc|vswitch)/")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudTagsDataSource_empty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudTagsDataSourceEmpty,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_tags.tagged"),
+					resource.TestCheckResourceAttr("data.alicloud_tags.tagged", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.alicloud_tags.tagged", "resources.#", "0"),
+					resource.TestCheckNoResourceAttr("data.alicloud_tags.tagged", "resources.0.id"),
+					resource.TestCheckNoResourceAttr("data.alicloud_tags.tagged", "resources.0.arn"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudTagsDataSourceVpcConfig = `
+variable "name" {
+	default = "tf-testAccCheckAlicloudTagsDataSourceVpcConfig"
+}
+
+data "alicloud_zones" "default" {
+	available_resource_creation = "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	cidr_block = "172.16.0.0/12"
+	name = "${var.name}"
+	tags {
+		Name = "${var.name}"
+	}
+}
+
+resource "alicloud_vswitch" "foo" {
+	vpc_id = "${alicloud_vpc.foo.id}"
+	cidr_block = "172.16.0.0/21"
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+	name = "${var.name}"
+	tags {
+		Name = "${var.name}"
+	}
+}
+
+data "alicloud_tags" "tagged" {
+	resource_types = ["alicloud_vpc", "alicloud_vswitch"]
+	tags {
+		Name = "${var.name}"
+	}
+	depends_on = ["alicloud_vswitch.foo"]
+}
+`
+
+const testAccCheckAlicloudTagsDataSourceEmpty = `
+data "alicloud_tags" "tagged" {
+	resource_types = ["alicloud_vpc", "alicloud_slb", "alicloud_db_instance"]
+	tags {
+		Name = "tf-testAccCheckAlicloudTagsDataSourceEmpty"
+	}
+}
+`
@@ -167,12 +167,18 @@ func ecsInternetDiffSuppressFunc(k, old, new string, d *schema.ResourceData) boo
 	return true
 }
 
+// ecsPostPaid returns whether an instance is PostPaid, which is the charge type of an instance not setting it.
+func ecsPostPaid(d *schema.ResourceData) bool {
+	chargeType := d.Get("instance_charge_type").(string)
+	return chargeType == "" || common.InstanceChargeType(chargeType) == common.PostPaid
+}
+
 func ecsPostPaidDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
-	return common.InstanceChargeType(d.Get("instance_charge_type").(string)) == common.PostPaid
+	return ecsPostPaid(d)
 }
 
 func ecsNotAutoRenewDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
-	if common.InstanceChargeType(d.Get("instance_charge_type").(string)) == common.PostPaid {
+	if ecsPostPaid(d) {
 		return true
 	}
 	if RenewalStatus(d.Get("renewal_status").(string)) == RenewAutoRenewal {
@@ -218,15 +224,28 @@ func rdsPostPaidDiffSuppressFunc(k, old, new string, d *schema.ResourceData) boo
 }
 
 func ecsSpotStrategyDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
-	if d.Get("instance_charge_type").(string) == string(PostPaid) {
+	if ecsPostPaid(d) {
 		return false
 	}
 	return true
 }
 
+// ecsLaunchTemplateDiffSuppressFunc ignores an instance argument which is not set when the instance is
+// launched from a template, since the template supplies its value. An argument not set in the configuration
+// is read from the state, so that its planned value is the same as the one in the state.
+func ecsLaunchTemplateDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("launch_template_id").(string) == "" {
+		return false
+	}
+	o, n := d.GetChange(strings.Split(k, ".")[0])
+	if set, ok := o.(*schema.Set); ok {
+		return set.Equal(n)
+	}
+	return o == n
+}
+
 func ecsSpotPriceLimitDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
-	if common.InstanceChargeType(d.Get("instance_charge_type").(string)) == common.PostPaid &&
-		ecs.SpotStrategyType(d.Get("spot_strategy").(string)) == ecs.SpotWithPriceLimit {
+	if ecsPostPaid(d) && ecs.SpotStrategyType(d.Get("spot_strategy").(string)) == ecs.SpotWithPriceLimit {
 		return false
 	}
 	return true
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"fmt"
 
@@ -132,9 +133,15 @@ const (
 	NoSuchCORSConfiguration    = "NoSuchCORSConfiguration"
 	NoSuchWebsiteConfiguration = "NoSuchWebsiteConfiguration"
 
+	NoSuchServerSideEncryptionRule          = "NoSuchServerSideEncryptionRule"
+	NoSuchBucketPolicy                      = "NoSuchBucketPolicy"
+	NoSuchTransferAccelerationConfiguration = "NoSuchTransferAccelerationConfiguration"
+	NoSuchReplicationConfiguration          = "NoSuchReplicationConfiguration"
+
 	// RAM Instance Not Found
 	RamInstanceNotFound   = "Forbidden.InstanceNotFound"
 	AliyunGoClientFailure = "AliyunGoClientFailure"
+	ServerUnreachable     = "SDK.ServerUnreachable"
 
 	// dns
 	RecordForbiddenDNSChange    = "RecordForbidden.DNSChange"
@@ -192,6 +199,7 @@ const (
 	// CS
 	ErrorClusterNameAlreadyExist = "ErrorClusterNameAlreadyExist"
 	ApplicationNotFound          = "Not Found"
+	ErrorNodePoolNotFound        = "ErrorNodePoolNotFound"
 	ApplicationErrorIgnore       = "Unable to reach primary cluster manager"
 	ApplicationConfirmConflict   = "Conflicts with unconfirmed updates for operation"
 
@@ -217,6 +225,8 @@ const (
 	ServiceNotFound  = "ServiceNotFound"
 	FunctionNotFound = "FunctionNotFound"
 	TriggerNotFound  = "TriggerNotFound"
+	AliasNotFound    = "AliasNotFound"
+	VersionNotFound  = "VersionNotFound"
 	AccessDenied     = "AccessDenied"
 
 	// Vpn
@@ -250,6 +260,11 @@ const (
 	InstanceNotExist                 = "Instance.NotExist"
 	// kv-store
 	InvalidKVStoreInstanceIdNotFound = "InvalidInstanceId.NotFound"
+	KVStoreInvalidStatus             = "IncorrectDBInstanceState"
+	KVStoreAccountNameDuplicate      = "InvalidAccountName.Duplicate"
+	// MongoDB
+	InvalidMongoDBInstanceIdNotFound = "InvalidDBInstanceId.NotFound"
+	MongoDBInvalidStatus             = "OperationDenied.DBInstanceStatus"
 	// MNS
 	QueueNotExist        = "QueueNotExist"
 	TopicNotExist        = "TopicNotExist"
@@ -265,6 +280,10 @@ const (
 var SlbIsBusy = []string{"SystemBusy", "OperationBusy", "ServiceIsStopping", "BackendServer.configuring", "ServiceIsConfiguring"}
 var EcsNotFound = []string{"InvalidInstanceId.NotFound", "Forbidden.InstanceNotFound"}
 var DiskInvalidOperation = []string{"IncorrectDiskStatus", "IncorrectInstanceStatus", "OperationConflict", InternalError, "InvalidOperation.Conflict", "IncorrectDiskStatus.Initializing"}
+var SnapshotInvalidOperations = []string{"IncorrectDiskStatus", "IncorrectSnapshotStatus", "OperationConflict", InternalError, "SnapshotCreatedDisk", "SnapshotCreatedImage"}
+var SnapshotNotFound = []string{"InvalidSnapshotId.NotFound", "InvalidDiskId.NotFound"}
+var ImageInvalidOperations = []string{"IncorrectImageStatus", "OperationConflict", InternalError, "ImageIsImporting"}
+var ImageNotFound = []string{"InvalidImageId.NotFound", "InvalidImageId.Malformed"}
 var NetworkInterfaceInvalidOperations = []string{"InvalidOperation.InvalidEniState", "InvalidOperation.InvalidEcsState", "OperationConflict", "ServiceUnavailable", "InternalError"}
 var OperationDeniedDBStatus = []string{"OperationDenied.DBStatus", OperationDeniedDBInstanceStatus, DBInternalError}
 
@@ -394,3 +413,12 @@ func GetNotFoundMessage(product, id string) string {
 func GetTimeoutMessage(product, status string) string {
 	return fmt.Sprintf("Waitting for %s %s is timeout.", product, status)
 }
+
+// GetWaitTimeoutMessage is the timeout message of StateWaiter. An empty target means waiting for the resource to be absent.
+func GetWaitTimeoutMessage(product, id string, target []string, timeout time.Duration) string {
+	state := "absent"
+	if len(target) > 0 {
+		state = strings.Join(target, ", ")
+	}
+	return fmt.Sprintf("Waiting for %s %s to become %s timed out after %s.", product, id, state, timeout)
+}
@@ -0,0 +1,148 @@
+package alicloud
+
+import (
+	"github.com/denverdino/aliyungo/common"
+	"github.com/denverdino/aliyungo/cs"
+	"github.com/denverdino/aliyungo/ecs"
+)
+
+type NodePoolState string
+
+const (
+	NodePoolActive   = NodePoolState("active")
+	NodePoolInitial  = NodePoolState("initial")
+	NodePoolScaling  = NodePoolState("scaling")
+	NodePoolRemoving = NodePoolState("removing")
+	NodePoolUpdating = NodePoolState("updating")
+	NodePoolDeleting = NodePoolState("deleting")
+	NodePoolFailed   = NodePoolState("failed")
+)
+
+type NodePoolInfo struct {
+	NodePoolId string        `json:"nodepool_id,omitempty"`
+	RegionId   common.Region `json:"region_id,omitempty"`
+	Name       string        `json:"name,omitempty"`
+	IsDefault  bool          `json:"is_default,omitempty"`
+	Type       string        `json:"type,omitempty"`
+}
+
+type NodePoolDataDisk struct {
+	Category  string `json:"category"`
+	Size      int    `json:"size"`
+	Encrypted string `json:"encrypted,omitempty"`
+}
+
+type NodePoolSpotPrice struct {
+	InstanceType string `json:"instance_type"`
+	PriceLimit   string `json:"price_limit"`
+}
+
+type NodePoolScalingGroup struct {
+	VpcId              string              `json:"vpc_id,omitempty"`
+	VswitchIds         []string            `json:"vswitch_ids,omitempty"`
+	InstanceTypes      []string            `json:"instance_types,omitempty"`
+	LoginPassword      string              `json:"login_password,omitempty"`
+	KeyPair            string              `json:"key_pair,omitempty"`
+	SecurityGroupId    string              `json:"security_group_id,omitempty"`
+	SystemDiskCategory ecs.DiskCategory    `json:"system_disk_category,omitempty"`
+	SystemDiskSize     int64               `json:"system_disk_size,omitempty"`
+	DataDisks          []NodePoolDataDisk  `json:"data_disks"`
+	ImageId            string              `json:"image_id,omitempty"`
+	InstanceChargeType string              `json:"instance_charge_type,omitempty"`
+	SpotStrategy       string              `json:"spot_strategy,omitempty"`
+	SpotPriceLimit     []NodePoolSpotPrice `json:"spot_price_limit"`
+	ScalingGroupId     string              `json:"scaling_group_id,omitempty"`
+}
+
+type NodePoolAutoScaling struct {
+	Enable       bool   `json:"enable"`
+	MaxInstances int64  `json:"max_instances"`
+	MinInstances int64  `json:"min_instances"`
+	Type         string `json:"type,omitempty"`
+}
+
+type NodePoolLabel struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type NodePoolTaint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Effect string `json:"effect"`
+}
+
+type NodePoolKubernetesConfig struct {
+	Labels       []NodePoolLabel `json:"labels"`
+	Taints       []NodePoolTaint `json:"taints"`
+	CmsEnabled   bool            `json:"cms_enabled"`
+	NodeNameMode string          `json:"node_name_mode,omitempty"`
+}
+
+type NodePoolStatus struct {
+	TotalNodes    int           `json:"total_nodes"`
+	ServingNodes  int           `json:"serving_nodes"`
+	HealthyNodes  int           `json:"healthy_nodes"`
+	FailedNodes   int           `json:"failed_nodes"`
+	InitialNodes  int           `json:"initial_nodes"`
+	RemovingNodes int           `json:"removing_nodes"`
+	OfflineNodes  int           `json:"offline_nodes"`
+	State         NodePoolState `json:"state"`
+}
+
+type NodePoolDetail struct {
+	cs.Response
+	NodePoolInfo             `json:"nodepool_info"`
+	NodePoolStatus           `json:"status"`
+	NodePoolScalingGroup     `json:"scaling_group"`
+	NodePoolKubernetesConfig `json:"kubernetes_config"`
+	NodePoolAutoScaling      `json:"auto_scaling"`
+}
+
+type CreateNodePoolArgs struct {
+	RegionId                 common.Region `json:"region_id"`
+	Count                    int64         `json:"count"`
+	NodePoolInfo             `json:"nodepool_info"`
+	NodePoolScalingGroup     `json:"scaling_group"`
+	NodePoolKubernetesConfig `json:"kubernetes_config"`
+	NodePoolAutoScaling      `json:"auto_scaling"`
+}
+
+type CreateNodePoolResponse struct {
+	cs.Response
+	NodePoolId string `json:"nodepool_id"`
+	TaskId     string `json:"task_id"`
+}
+
+type UpdateNodePoolArgs struct {
+	RegionId                 common.Region `json:"region_id"`
+	NodePoolInfo             `json:"nodepool_info"`
+	NodePoolScalingGroup     `json:"scaling_group"`
+	NodePoolKubernetesConfig `json:"kubernetes_config"`
+	NodePoolAutoScaling      `json:"auto_scaling"`
+}
+
+type ScaleOutNodePoolArgs struct {
+	Count int64 `json:"count"`
+}
+
+type RemoveClusterNodesArgs struct {
+	Nodes       []string `json:"nodes"`
+	ReleaseNode bool     `json:"release_node"`
+	DrainNode   bool     `json:"drain_node"`
+}
+
+// KubernetesNodePoolNode is the node returned by the API /clusters/{cluster_id}/nodes, which
+// contains the node name and node pool of the node besides the fields of cs.KubernetesNodeType.
+type KubernetesNodePoolNode struct {
+	cs.KubernetesNodeType
+	NodeName   string `json:"node_name"`
+	NodePoolId string `json:"nodepool_id"`
+	State      string `json:"state"`
+}
+
+type GetKubernetesNodePoolNodesResponse struct {
+	cs.Response
+	Page  cs.PaginationResult      `json:"page"`
+	Nodes []KubernetesNodePoolNode `json:"nodes"`
+}
@@ -0,0 +1,127 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/aliyun/fc-go-sdk"
+)
+
+// The FC API paths which are relative to the API version.
+const (
+	fcFunctionsPath       = "/services/%s/functions"
+	fcSingleFunctionPath  = fcFunctionsPath + "/%s"
+	fcTriggersPath        = fcSingleFunctionPath + "/triggers"
+	fcSingleTriggerPath   = fcTriggersPath + "/%s"
+	fcVersionsPath        = "/services/%s/versions"
+	fcSingleVersionPath   = fcVersionsPath + "/%s"
+	fcAliasesPath         = "/services/%s/aliases"
+	fcSingleAliasPath     = fcAliasesPath + "/%s"
+	fcProvisionConfigPath = "/services/%s.%s/functions/%s/provision-config"
+)
+
+// fcPath formats the API path with the escaped names.
+func fcPath(format string, names ...string) string {
+	args := make([]interface{}, len(names))
+	for i, name := range names {
+		args[i] = url.PathEscape(name)
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// fcFunctionExtra holds the function fields which the vendored fc-go-sdk does not support.
+type fcFunctionExtra struct {
+	Initializer           *string `json:"initializer,omitempty"`
+	InitializationTimeout *int32  `json:"initializationTimeout,omitempty"`
+	InstanceConcurrency   *int32  `json:"instanceConcurrency,omitempty"`
+}
+
+type fcFunctionCreateObject struct {
+	fc.FunctionCreateObject
+	fcFunctionExtra
+}
+
+type fcFunctionUpdateObject struct {
+	fc.FunctionUpdateObject
+	fcFunctionExtra
+}
+
+type fcFunction struct {
+	fc.GetFunctionOutput
+	fcFunctionExtra
+}
+
+type fcTriggerCreateObject struct {
+	fc.TriggerCreateObject
+	Qualifier *string `json:"qualifier,omitempty"`
+}
+
+type fcTriggerUpdateObject struct {
+	fc.TriggerUpdateObject
+	Qualifier *string `json:"qualifier,omitempty"`
+}
+
+// fcTrigger is the trigger metadata. Unlike the one of the SDK, it can be read for any trigger type.
+type fcTrigger struct {
+	TriggerName      *string         `json:"triggerName"`
+	SourceARN        *string         `json:"sourceArn"`
+	TriggerType      *string         `json:"triggerType"`
+	InvocationRole   *string         `json:"invocationRole"`
+	Qualifier        *string         `json:"qualifier"`
+	RawTriggerConfig json.RawMessage `json:"triggerConfig"`
+	CreatedTime      *string         `json:"createdTime"`
+	LastModifiedTime *string         `json:"lastModifiedTime"`
+}
+
+type fcServiceVersion struct {
+	VersionID        *string `json:"versionId"`
+	Description      *string `json:"description"`
+	CreatedTime      *string `json:"createdTime"`
+	LastModifiedTime *string `json:"lastModifiedTime"`
+}
+
+type fcServiceVersions struct {
+	Versions  []fcServiceVersion `json:"versions"`
+	NextToken *string            `json:"nextToken"`
+}
+
+// fcAliasObject is the body to create or update an alias. A nil AdditionalVersionWeight means no change, and
+// it points to an empty map to remove the routing.
+type fcAliasObject struct {
+	AliasName               *string             `json:"aliasName,omitempty"`
+	VersionID               *string             `json:"versionId,omitempty"`
+	Description             *string             `json:"description,omitempty"`
+	AdditionalVersionWeight *map[string]float64 `json:"additionalVersionWeight,omitempty"`
+}
+
+type fcAlias struct {
+	fcAliasObject
+	CreatedTime      *string `json:"createdTime"`
+	LastModifiedTime *string `json:"lastModifiedTime"`
+}
+
+type fcProvisionConfig struct {
+	Resource *string `json:"resource,omitempty"`
+	Target   *int64  `json:"target"`
+	Current  *int64  `json:"current,omitempty"`
+}
+
+// The trigger types which the vendored fc-go-sdk does not support.
+const (
+	fcTriggerTypeMnsTopic  = "mns_topic"
+	fcTriggerTypeCdnEvents = "cdn_events"
+)
+
+type fcMnsTopicTriggerConfig struct {
+	FilterTag           *string `json:"filterTag,omitempty"`
+	NotifyContentFormat *string `json:"notifyContentFormat"`
+	NotifyStrategy      *string `json:"notifyStrategy"`
+}
+
+type fcCdnEventsTriggerConfig struct {
+	EventName    *string             `json:"eventName"`
+	EventVersion *string             `json:"eventVersion"`
+	Notes        *string             `json:"notes"`
+	Filter       map[string][]string `json:"filter"`
+}
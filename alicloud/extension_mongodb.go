@@ -0,0 +1,15 @@
+package alicloud
+
+type MongoDBStorageEngine string
+
+const (
+	WiredTiger = MongoDBStorageEngine("WiredTiger")
+	RocksDB    = MongoDBStorageEngine("RocksDB")
+)
+
+type MongoDBNodeType string
+
+const (
+	MongoDBShardNode  = MongoDBNodeType("shard")
+	MongoDBMongosNode = MongoDBNodeType("mongos")
+)
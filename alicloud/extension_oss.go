@@ -13,6 +13,43 @@ const (
 	ExpirationStatusDisabled = LifecycleRuleStatus("Disabled")
 )
 
+type VersioningStatus string
+
+const (
+	VersioningEnabled   = VersioningStatus("Enabled")
+	VersioningSuspended = VersioningStatus("Suspended")
+)
+
+type SSEAlgorithm string
+
+const (
+	SSEAlgorithmAES256 = SSEAlgorithm("AES256")
+	SSEAlgorithmKMS    = SSEAlgorithm("KMS")
+)
+
+type ReplicationAction string
+
+const (
+	ReplicationActionAll = ReplicationAction("ALL")
+	ReplicationActionPut = ReplicationAction("PUT")
+)
+
+type HistoricalObjectReplication string
+
+const (
+	HistoricalObjectReplicationEnabled  = HistoricalObjectReplication("enabled")
+	HistoricalObjectReplicationDisabled = HistoricalObjectReplication("disabled")
+)
+
+const (
+	// OssMultipartThreshold is the file size above which the file is uploaded by multipart upload
+	OssMultipartThreshold = 100 * 1024 * 1024
+	OssMultipartPartSize  = 10 * 1024 * 1024
+	OssMultipartRoutines  = 3
+	// OssMaxKeys is the max number of the objects listed or deleted in one request
+	OssMaxKeys = 1000
+)
+
 func ossNotFoundError(err error) bool {
 	if e, ok := err.(oss.ServiceError); ok &&
 		(e.StatusCode == 404 || strings.HasPrefix(e.Code, "NoSuch") || strings.HasPrefix(e.Message, "No Row found")) {
@@ -51,3 +51,36 @@ const (
 	KVStore2Dot8 = KVStoreEngineVersion("2.8")
 	KVStore4Dot0 = KVStoreEngineVersion("4.0")
 )
+
+type KVStoreArchitectureType string
+
+const (
+	KVStoreStandard = KVStoreArchitectureType("standard")
+	KVStoreCluster  = KVStoreArchitectureType("cluster")
+	KVStoreRWSplit  = KVStoreArchitectureType("rwsplit")
+)
+
+type KVStoreAccountPrivilege string
+
+const (
+	KVStoreRoleReadOnly  = KVStoreAccountPrivilege("RoleReadOnly")
+	KVStoreRoleReadWrite = KVStoreAccountPrivilege("RoleReadWrite")
+	KVStoreRoleRepl      = KVStoreAccountPrivilege("RoleRepl")
+)
+
+type RdsSecurityStatus string
+
+const (
+	RdsSecurityEnabled  = RdsSecurityStatus("Enabled")
+	RdsSecurityDisabled = RdsSecurityStatus("Disabled")
+)
+
+type RdsSSLCAType string
+
+const (
+	SSLCATypeAliyun = RdsSSLCAType("aliyun")
+	SSLCATypeCustom = RdsSSLCAType("custom")
+)
+
+// The days that the SQL audit logs can be retained for.
+var SQL_COLLECTOR_RETENTION = []int{30, 180, 365, 1095, 1825}
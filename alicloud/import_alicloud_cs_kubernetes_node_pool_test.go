@@ -0,0 +1,31 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudCSKubernetesNodePool_import(t *testing.T) {
+	resourceName := "alicloud_cs_kubernetes_node_pool.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckWithRegions(t, true, connectivity.ManagedKubernetesSupportedRegions) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesNodePool(1, ""),
+			},
+
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
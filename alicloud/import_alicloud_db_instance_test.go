@@ -22,6 +22,8 @@ func TestAccAlicloudDBInstance_import(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// force_restart only takes effect while applying and it can not be read from the instance
+				ImportStateVerifyIgnore: []string{"force_restart"},
 			},
 		},
 	})
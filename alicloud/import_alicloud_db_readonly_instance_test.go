@@ -0,0 +1,28 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDBReadonlyInstance_import(t *testing.T) {
+	resourceName := "alicloud_db_readonly_instance.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDBReadonlyInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBReadonlyInstance_vpc(DatabaseCommonTestCase, "rds.mysql.t1.small", 20),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudFCAlias_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckWithRegions(t, false, connectivity.FcNoSupportedRegions) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlicloudFCAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudFCAliasBasic(acctest.RandInt()),
+			},
+
+			{
+				ResourceName:      "alicloud_fc_alias.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudKVStoreAccount_import(t *testing.T) {
+	resourceName := "alicloud_kvstore_account.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKVStoreAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVStoreAccountConfig(string(KVStoreRoleReadOnly), "from terraform"),
+			},
+
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
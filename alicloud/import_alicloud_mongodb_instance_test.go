@@ -0,0 +1,51 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudMongoDBInstance_importBasic(t *testing.T) {
+	resourceName := "alicloud_mongodb_instance.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBInstance_vpc("tf-testAccMongoDBInstance_import", "dds.mongo.mid", 10),
+			},
+
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"account_password", "period"},
+			},
+		},
+	})
+}
+
+func TestAccAlicloudMongoDBShardingInstance_importBasic(t *testing.T) {
+	resourceName := "alicloud_mongodb_sharding_instance.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBShardingInstance_vpc("tf-testAccMongoDBShardingInstance_import", 10, 10),
+			},
+
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"account_password", "period"},
+			},
+		},
+	})
+}
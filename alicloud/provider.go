@@ -1,7 +1,11 @@
 package alicloud
 
 import (
+	"fmt"
+	"log"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,16 +17,15 @@ import (
 func Provider() terraform.ResourceProvider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
+			// The environment variables of access key are loaded by the credential chain, after the static credential.
 			"access_key": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_ACCESS_KEY", os.Getenv("ALICLOUD_ACCESS_KEY")),
+				Optional:    true,
 				Description: descriptions["access_key"],
 			},
 			"secret_key": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_SECRET_KEY", os.Getenv("ALICLOUD_SECRET_KEY")),
+				Optional:    true,
 				Description: descriptions["secret_key"],
 			},
 			"region": {
@@ -47,12 +50,14 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("LOG_ENDPOINT", os.Getenv("LOG_ENDPOINT")),
 				Description: descriptions["log_endpoint"],
+				Deprecated:  "Field 'log_endpoint' has been deprecated from provider version 1.28.0. New field 'log' which in nested endpoints instead.",
 			},
 			"mns_endpoint": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("MNS_ENDPOINT", os.Getenv("MNS_ENDPOINT")),
 				Description: descriptions["mns_endpoint"],
+				Deprecated:  "Field 'mns_endpoint' has been deprecated from provider version 1.28.0. New field 'mns' which in nested endpoints instead.",
 			},
 			"account_id": {
 				Type:        schema.TypeString,
@@ -66,6 +71,75 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("FC_ENDPOINT", os.Getenv("FC_ENDPOINT")),
 				Description: descriptions["fc"],
+				Deprecated:  "Field 'fc' has been deprecated from provider version 1.28.0. New field 'fc' which in nested endpoints instead.",
+			},
+			"endpoints":   endpointsSchema(),
+			"assume_role": assumeRoleSchema(),
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_PROFILE", ""),
+				Description: descriptions["profile"],
+			},
+			"shared_credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_SHARED_CREDENTIALS_FILE", ""),
+				Description: descriptions["shared_credentials_file"],
+			},
+			"ecs_role_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_ECS_ROLE_NAME", ""),
+				Description: descriptions["ecs_role_name"],
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      connectivity.DefaultMaxRetries,
+				ValidateFunc: validateIntegerInRange(0, 100),
+				Description:  descriptions["max_retries"],
+			},
+			"retry_base_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(connectivity.DefaultRetryBaseDelay / time.Second),
+				ValidateFunc: validateIntegerInRange(1, 60),
+				Description:  descriptions["retry_base_delay"],
+			},
+			"retry_max_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(connectivity.DefaultRetryMaxDelay / time.Second),
+				ValidateFunc: validateIntegerInRange(1, 600),
+				Description:  descriptions["retry_max_delay"],
+			},
+			"api_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_API_TRACE_FILE", nil),
+				Description: descriptions["api_trace_file"],
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["default_tags"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: descriptions["default_tags_tags"],
+						},
+					},
+				},
+			},
+			"api_rate_limits": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: descriptions["api_rate_limits"],
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
@@ -77,6 +151,7 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_instance_types":     dataSourceAlicloudInstanceTypes(),
 			"alicloud_instances":          dataSourceAlicloudInstances(),
 			"alicloud_disks":              dataSourceAlicloudDisks(),
+			"alicloud_snapshots":          dataSourceAlicloudSnapshots(),
 			"alicloud_network_interfaces": dataSourceAlicloudNetworkInterfaces(),
 			"alicloud_vpcs":               dataSourceAlicloudVpcs(),
 			"alicloud_vswitches":          dataSourceAlicloudVSwitches(),
@@ -111,6 +186,7 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_fc_functions":             dataSourceAlicloudFcFunctions(),
 			"alicloud_fc_services":              dataSourceAlicloudFcServices(),
 			"alicloud_fc_triggers":              dataSourceAlicloudFcTriggers(),
+			"alicloud_file_crc64_checksum":      dataSourceAlicloudFileCRC64Checksum(),
 			"alicloud_db_instances":             dataSourceAlicloudDBInstances(),
 			"alicloud_pvtz_zones":               dataSourceAlicloudPvtzZones(),
 			"alicloud_pvtz_zone_records":        dataSourceAlicloudPvtzZoneRecords(),
@@ -131,12 +207,18 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_api_gateway_apis":         dataSourceAlicloudApiGatewayApis(),
 			"alicloud_api_gateway_groups":       dataSourceAlicloudApiGatewayGroups(),
 			"alicloud_api_gateway_apps":         dataSourceAlicloudApiGatewayApps(),
+			"alicloud_tags":                     dataSourceAlicloudTags(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"alicloud_instance":                     resourceAliyunInstance(),
 			"alicloud_ram_role_attachment":          resourceAlicloudRamRoleAttachment(),
 			"alicloud_disk":                         resourceAliyunDisk(),
 			"alicloud_disk_attachment":              resourceAliyunDiskAttachment(),
+			"alicloud_snapshot":                     resourceAliyunSnapshot(),
+			"alicloud_snapshot_policy":              resourceAliyunSnapshotPolicy(),
+			"alicloud_snapshot_policy_attachment":   resourceAliyunSnapshotPolicyAttachment(),
+			"alicloud_image":                        resourceAliyunImage(),
+			"alicloud_launch_template":              resourceAliyunLaunchTemplate(),
 			"alicloud_network_interface":            resourceAliyunNetworkInterface(),
 			"alicloud_network_interface_attachment": resourceAliyunNetworkInterfaceAttachment(),
 			"alicloud_security_group":               resourceAliyunSecurityGroup(),
@@ -147,6 +229,7 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_db_backup_policy":             resourceAlicloudDBBackupPolicy(),
 			"alicloud_db_connection":                resourceAlicloudDBConnection(),
 			"alicloud_db_instance":                  resourceAlicloudDBInstance(),
+			"alicloud_db_readonly_instance":         resourceAlicloudDBReadonlyInstance(),
 			"alicloud_ess_scaling_group":            resourceAlicloudEssScalingGroup(),
 			"alicloud_ess_scaling_configuration":    resourceAlicloudEssScalingConfiguration(),
 			"alicloud_ess_scaling_rule":             resourceAlicloudEssScalingRule(),
@@ -157,37 +240,38 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_vpc":                          resourceAliyunVpc(),
 			"alicloud_nat_gateway":                  resourceAliyunNatGateway(),
 			// "alicloud_subnet" aims to match aws usage habit.
-			"alicloud_subnet":                 resourceAliyunSubnet(),
-			"alicloud_vswitch":                resourceAliyunSubnet(),
-			"alicloud_route_entry":            resourceAliyunRouteEntry(),
-			"alicloud_route_table":            resourceAliyunRouteTable(),
-			"alicloud_route_table_attachment": resourceAliyunRouteTableAttachment(),
-			"alicloud_snat_entry":             resourceAliyunSnatEntry(),
-			"alicloud_forward_entry":          resourceAliyunForwardEntry(),
-			"alicloud_eip":                    resourceAliyunEip(),
-			"alicloud_eip_association":        resourceAliyunEipAssociation(),
-			"alicloud_slb":                    resourceAliyunSlb(),
-			"alicloud_slb_listener":           resourceAliyunSlbListener(),
-			"alicloud_slb_attachment":         resourceAliyunSlbAttachment(),
-			"alicloud_slb_server_group":       resourceAliyunSlbServerGroup(),
-			"alicloud_slb_rule":               resourceAliyunSlbRule(),
-			"alicloud_slb_acl":                resourceAlicloudSlbAcl(),
-			"alicloud_slb_ca_certificate":     resourceAlicloudSlbCACertificate(),
-			"alicloud_slb_server_certificate": resourceAlicloudSlbServerCertificate(),
-			"alicloud_oss_bucket":             resourceAlicloudOssBucket(),
-			"alicloud_oss_bucket_object":      resourceAlicloudOssBucketObject(),
-			"alicloud_dns_record":             resourceAlicloudDnsRecord(),
-			"alicloud_dns":                    resourceAlicloudDns(),
-			"alicloud_dns_group":              resourceAlicloudDnsGroup(),
-			"alicloud_key_pair":               resourceAlicloudKeyPair(),
-			"alicloud_key_pair_attachment":    resourceAlicloudKeyPairAttachment(),
-			"alicloud_kms_key":                resourceAlicloudKmsKey(),
-			"alicloud_ram_user":               resourceAlicloudRamUser(),
-			"alicloud_ram_access_key":         resourceAlicloudRamAccessKey(),
-			"alicloud_ram_login_profile":      resourceAlicloudRamLoginProfile(),
-			"alicloud_ram_group":              resourceAlicloudRamGroup(),
-			"alicloud_ram_role":               resourceAlicloudRamRole(),
-			"alicloud_ram_policy":             resourceAlicloudRamPolicy(),
+			"alicloud_subnet":                  resourceAliyunSubnet(),
+			"alicloud_vswitch":                 resourceAliyunSubnet(),
+			"alicloud_route_entry":             resourceAliyunRouteEntry(),
+			"alicloud_route_table":             resourceAliyunRouteTable(),
+			"alicloud_route_table_attachment":  resourceAliyunRouteTableAttachment(),
+			"alicloud_snat_entry":              resourceAliyunSnatEntry(),
+			"alicloud_forward_entry":           resourceAliyunForwardEntry(),
+			"alicloud_eip":                     resourceAliyunEip(),
+			"alicloud_eip_association":         resourceAliyunEipAssociation(),
+			"alicloud_slb":                     resourceAliyunSlb(),
+			"alicloud_slb_listener":            resourceAliyunSlbListener(),
+			"alicloud_slb_attachment":          resourceAliyunSlbAttachment(),
+			"alicloud_slb_server_group":        resourceAliyunSlbServerGroup(),
+			"alicloud_slb_rule":                resourceAliyunSlbRule(),
+			"alicloud_slb_acl":                 resourceAlicloudSlbAcl(),
+			"alicloud_slb_ca_certificate":      resourceAlicloudSlbCACertificate(),
+			"alicloud_slb_server_certificate":  resourceAlicloudSlbServerCertificate(),
+			"alicloud_oss_bucket":              resourceAlicloudOssBucket(),
+			"alicloud_oss_bucket_object":       resourceAlicloudOssBucketObject(),
+			"alicloud_oss_bucket_objects_sync": resourceAlicloudOssBucketObjectsSync(),
+			"alicloud_dns_record":              resourceAlicloudDnsRecord(),
+			"alicloud_dns":                     resourceAlicloudDns(),
+			"alicloud_dns_group":               resourceAlicloudDnsGroup(),
+			"alicloud_key_pair":                resourceAlicloudKeyPair(),
+			"alicloud_key_pair_attachment":     resourceAlicloudKeyPairAttachment(),
+			"alicloud_kms_key":                 resourceAlicloudKmsKey(),
+			"alicloud_ram_user":                resourceAlicloudRamUser(),
+			"alicloud_ram_access_key":          resourceAlicloudRamAccessKey(),
+			"alicloud_ram_login_profile":       resourceAlicloudRamLoginProfile(),
+			"alicloud_ram_group":               resourceAlicloudRamGroup(),
+			"alicloud_ram_role":                resourceAlicloudRamRole(),
+			"alicloud_ram_policy":              resourceAlicloudRamPolicy(),
 			// alicloud_ram_alias has been deprecated
 			"alicloud_ram_alias":                           resourceAlicloudRamAccountAlias(),
 			"alicloud_ram_account_alias":                   resourceAlicloudRamAccountAlias(),
@@ -200,6 +284,7 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_cs_swarm":                            resourceAlicloudCSSwarm(),
 			"alicloud_cs_kubernetes":                       resourceAlicloudCSKubernetes(),
 			"alicloud_cs_managed_kubernetes":               resourceAlicloudCSManagedKubernetes(),
+			"alicloud_cs_kubernetes_node_pool":             resourceAlicloudCSKubernetesNodePool(),
 			"alicloud_cdn_domain":                          resourceAlicloudCdnDomain(),
 			"alicloud_router_interface":                    resourceAlicloudRouterInterface(),
 			"alicloud_router_interface_connection":         resourceAlicloudRouterInterfaceConnection(),
@@ -217,6 +302,9 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_fc_service":                          resourceAlicloudFCService(),
 			"alicloud_fc_function":                         resourceAlicloudFCFunction(),
 			"alicloud_fc_trigger":                          resourceAlicloudFCTrigger(),
+			"alicloud_fc_service_version":                  resourceAlicloudFCServiceVersion(),
+			"alicloud_fc_alias":                            resourceAlicloudFCAlias(),
+			"alicloud_fc_provision_config":                 resourceAlicloudFCProvisionConfig(),
 			"alicloud_vpn_gateway":                         resourceAliyunVpnGateway(),
 			"alicloud_vpn_customer_gateway":                resourceAliyunVpnCustomerGateway(),
 			"alicloud_vpn_connection":                      resourceAliyunVpnConnection(),
@@ -229,7 +317,10 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_cen_bandwidth_limit":                 resourceAlicloudCenBandwidthLimit(),
 			"alicloud_cen_route_entry":                     resourceAlicloudCenRouteEntry(),
 			"alicloud_kvstore_instance":                    resourceAlicloudKVStoreInstance(),
+			"alicloud_kvstore_account":                     resourceAlicloudKVStoreAccount(),
 			"alicloud_kvstore_backup_policy":               resourceAlicloudKVStoreBackupPolicy(),
+			"alicloud_mongodb_instance":                    resourceAlicloudMongoDBInstance(),
+			"alicloud_mongodb_sharding_instance":           resourceAlicloudMongoDBShardingInstance(),
 			"alicloud_datahub_project":                     resourceAlicloudDatahubProject(),
 			"alicloud_datahub_subscription":                resourceAlicloudDatahubSubscription(),
 			"alicloud_datahub_topic":                       resourceAlicloudDatahubTopic(),
@@ -274,19 +365,83 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.OtsInstanceName = Trim(ots_instance_name.(string))
 	}
 
-	if logEndpoint, ok := d.GetOk("log_endpoint"); ok && logEndpoint.(string) != "" {
-		config.LogEndpoint = Trim(logEndpoint.(string))
+	config.Endpoints = make(map[connectivity.ServiceCode]string)
+	if v, ok := d.GetOk("endpoints"); ok {
+		for _, raw := range v.(*schema.Set).List() {
+			endpoints := raw.(map[string]interface{})
+			for name, serviceCode := range endpointServiceCodes {
+				if endpoint, ok := endpoints[name].(string); ok && Trim(endpoint) != "" {
+					config.Endpoints[serviceCode] = Trim(endpoint)
+				}
+			}
+		}
 	}
-	if mnsEndpoint, ok := d.GetOk("mns_endpoint"); ok && mnsEndpoint.(string) != "" {
-		config.MNSEndpoint = Trim(mnsEndpoint.(string))
+
+	// The deprecated endpoint fields only take effect when the nested endpoints block does not set them.
+	if logEndpoint, ok := d.GetOk("log_endpoint"); ok && logEndpoint.(string) != "" && config.Endpoints[connectivity.LOGCode] == "" {
+		config.Endpoints[connectivity.LOGCode] = Trim(logEndpoint.(string))
+	}
+	if mnsEndpoint, ok := d.GetOk("mns_endpoint"); ok && mnsEndpoint.(string) != "" && config.Endpoints[connectivity.MNSCode] == "" {
+		config.Endpoints[connectivity.MNSCode] = Trim(mnsEndpoint.(string))
 	}
 
 	if account, ok := d.GetOk("account_id"); ok && account.(string) != "" {
 		config.AccountId = Trim(account.(string))
 	}
 
-	if fcEndpoint, ok := d.GetOk("fc"); ok && fcEndpoint.(string) != "" {
-		config.FcEndpoint = Trim(fcEndpoint.(string))
+	if fcEndpoint, ok := d.GetOk("fc"); ok && fcEndpoint.(string) != "" && config.Endpoints[connectivity.FCCode] == "" {
+		config.Endpoints[connectivity.FCCode] = Trim(fcEndpoint.(string))
+	}
+
+	if profile, ok := d.GetOk("profile"); ok && profile.(string) != "" {
+		config.Profile = Trim(profile.(string))
+	}
+	if file, ok := d.GetOk("shared_credentials_file"); ok && file.(string) != "" {
+		config.SharedCredentialsFile = Trim(file.(string))
+	}
+	if roleName, ok := d.GetOk("ecs_role_name"); ok && roleName.(string) != "" {
+		config.EcsRoleName = Trim(roleName.(string))
+	}
+
+	config.MaxRetries = d.Get("max_retries").(int)
+	config.RetryBaseDelay = time.Duration(d.Get("retry_base_delay").(int)) * time.Second
+	config.RetryMaxDelay = time.Duration(d.Get("retry_max_delay").(int)) * time.Second
+	config.ApiTraceFile = d.Get("api_trace_file").(string)
+	if v, ok := d.GetOk("default_tags"); ok {
+		config.DefaultTags = make(map[string]string)
+		for _, raw := range v.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			for key, value := range raw.(map[string]interface{})["tags"].(map[string]interface{}) {
+				config.DefaultTags[key] = value.(string)
+			}
+		}
+	}
+	if limits, ok := d.GetOk("api_rate_limits"); ok {
+		config.ApiRateLimits = make(map[connectivity.ServiceCode]int)
+		for product, limit := range limits.(map[string]interface{}) {
+			serviceCode := connectivity.ServiceCode(strings.ToUpper(product))
+			if _, ok := connectivity.DefaultApiRateLimits[serviceCode]; !ok {
+				return nil, fmt.Errorf("The product %s of api_rate_limits is not supported.", product)
+			}
+			if limit.(int) < 0 {
+				return nil, fmt.Errorf("The rate limit of %s should not be negative, got %d.", product, limit.(int))
+			}
+			config.ApiRateLimits[serviceCode] = limit.(int)
+		}
+	}
+
+	if v, ok := d.GetOk("assume_role"); ok {
+		for _, raw := range v.(*schema.Set).List() {
+			assumeRole := raw.(map[string]interface{})
+			config.RamRoleArn = Trim(assumeRole["role_arn"].(string))
+			config.RamRoleSessionName = Trim(assumeRole["session_name"].(string))
+			config.RamRolePolicy = Trim(assumeRole["policy"].(string))
+			config.RamRoleSessionExpiration = assumeRole["session_expiration"].(int)
+		}
+		log.Printf("[INFO] assume_role configuration set: (RamRoleArn: %q, RamRoleSessionName: %q, RamRolePolicy: %q, RamRoleSessionExpiration: %d)",
+			config.RamRoleArn, config.RamRoleSessionName, config.RamRolePolicy, config.RamRoleSessionExpiration)
 	}
 
 	client, err := config.Client()
@@ -304,13 +459,117 @@ var descriptions map[string]string
 
 func init() {
 	descriptions = map[string]string{
-		"access_key":     "Access key of alicloud",
-		"secret_key":     "Secret key of alicloud",
-		"region":         "Region of alicloud",
-		"security_token": "Alibaba Cloud Security Token",
-		"log_endpoint":   "Alibaba Cloud log service self-define endpoint",
-		"mns_endpoint":   "Alibaba Cloud mns service self-define endpoint",
-		"account_id":     "Alibaba Cloud account ID",
-		"fc":             "Custom function compute endpoints",
+		"access_key":       "Access key of alicloud",
+		"secret_key":       "Secret key of alicloud",
+		"region":           "Region of alicloud",
+		"security_token":   "Alibaba Cloud Security Token",
+		"log_endpoint":     "Alibaba Cloud log service self-define endpoint",
+		"mns_endpoint":     "Alibaba Cloud mns service self-define endpoint",
+		"account_id":       "Alibaba Cloud account ID",
+		"fc":               "Custom function compute endpoints",
+		"max_retries":      "The maximum number of times an API call is retried when it is throttled or the service is unavailable. Default to 10.",
+		"retry_base_delay": "The base delay in seconds of the exponential backoff between the retries. Default to 1.",
+		"retry_max_delay":  "The maximum delay in seconds between the retries. Default to 30.",
+		"api_rate_limits":  "The maximum queries per second sent to each product, such as `ecs = 10`. 0 means no limit. The products which are not set use their default limits.",
+		"api_trace_file":   "The file which the product, action, request id, duration, retries and error code of every API call are appended to as JSON lines. If not set, the API calls are traced to the debug log.",
+		"endpoint":         "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom %s endpoints.",
+
+		"default_tags":      "Configuration block with the tags which are merged into the tags of every resource supporting tags.",
+		"default_tags_tags": "The tags merged into the tags of every resource. The tags set by a resource win over the ones with the same keys.",
+
+		"profile":                 "The profile for API operations. If not set, the current profile of the shared credentials file will be used.",
+		"shared_credentials_file": "The path to the shared credentials file. If not set this defaults to ~/.aliyun/config.json",
+		"ecs_role_name":           "The RAM Role Name attached on a ECS instance for API operations. You can retrieve this from the 'Access Control' section of the Alibaba Cloud console.",
+
+		"assume_role_role_arn":           "The ARN of a RAM role to assume prior to making API calls.",
+		"assume_role_session_name":       "The session name to use when assuming the role. If omitted, `terraform` is passed to the AssumeRole call as session name.",
+		"assume_role_policy":             "The permissions applied when assuming a role. It cannot grant further permissions than the ones of the role being assumed.",
+		"assume_role_session_expiration": "The time after which the established session for assuming role expires. Valid value range: [900-3600] seconds. Default to 3600.",
+	}
+}
+
+// endpointServiceCodes maps the fields of the nested endpoints block to the services.
+var endpointServiceCodes = map[string]connectivity.ServiceCode{
+	"ecs":      connectivity.ECSCode,
+	"ess":      connectivity.ESSCode,
+	"ram":      connectivity.RAMCode,
+	"vpc":      connectivity.VPCCode,
+	"slb":      connectivity.SLBCode,
+	"rds":      connectivity.RDSCode,
+	"oss":      connectivity.OSSCode,
+	"cdn":      connectivity.CDNCode,
+	"cms":      connectivity.CMSCode,
+	"kms":      connectivity.KMSCode,
+	"ots":      connectivity.OTSCode,
+	"dns":      connectivity.DNSCode,
+	"pvtz":     connectivity.PVTZCode,
+	"log":      connectivity.LOGCode,
+	"fc":       connectivity.FCCode,
+	"dds":      connectivity.DDSCode,
+	"sts":      connectivity.STSCode,
+	"cen":      connectivity.CENCode,
+	"kvstore":  connectivity.KVSTORECode,
+	"datahub":  connectivity.DATAHUBCode,
+	"mns":      connectivity.MNSCode,
+	"cloudapi": connectivity.CLOUDAPICode,
+	"drds":     connectivity.DRDSCode,
+	"location": connectivity.LOCATIONCode,
+}
+
+func endpointsSchema() *schema.Schema {
+	endpoints := make(map[string]*schema.Schema)
+	for name, serviceCode := range endpointServiceCodes {
+		endpoints[name] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: fmt.Sprintf(descriptions["endpoint"], serviceCode),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: endpoints,
+		},
+	}
+}
+
+func assumeRoleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_arn": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["assume_role_role_arn"],
+					DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_ASSUME_ROLE_ARN", os.Getenv("ALICLOUD_ASSUME_ROLE_ARN")),
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["assume_role_session_name"],
+					DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_ASSUME_ROLE_SESSION_NAME", ""),
+				},
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  descriptions["assume_role_policy"],
+					ValidateFunc: validateJsonString,
+				},
+				"session_expiration": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      connectivity.DefaultRoleSessionExpiration,
+					Description:  descriptions["assume_role_session_expiration"],
+					ValidateFunc: validateIntegerInRange(900, 3600),
+				},
+			},
+		},
 	}
 }
@@ -17,6 +17,9 @@ func resourceAliyunApigatewayAppAttachment() *schema.Resource {
 		Create: resourceAliyunApigatewayAppAttachmentCreate,
 		Read:   resourceAliyunApigatewayAppAttachmentRead,
 		Delete: resourceAliyunApigatewayAppAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 
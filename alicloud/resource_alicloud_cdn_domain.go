@@ -18,6 +18,9 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 		Read:   resourceAlicloudCdnDomainRead,
 		Update: resourceAlicloudCdnDomainUpdate,
 		Delete: resourceAlicloudCdnDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -88,6 +91,7 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 			"parameter_filter_config": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"enable": {
@@ -114,6 +118,7 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 			"page_404_config": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"page_type": {
@@ -138,6 +143,7 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 			"refer_config": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"refer_type": {
@@ -420,38 +426,46 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 	configs := resp.DomainConfigs
 
 	queryStringConfig := configs.IgnoreQueryStringConfig
-	if _, ok := d.GetOk("parameter_filter_config"); ok {
-		config := make([]map[string]interface{}, 1)
-		config[0] = map[string]interface{}{
-			"enable":        queryStringConfig.Enable,
-			"hash_key_args": strings.Split(queryStringConfig.HashKeyArgs, ","),
-		}
-		d.Set("parameter_filter_config", config)
+	hashKeyArgs := []string{}
+	if queryStringConfig.HashKeyArgs != "" {
+		hashKeyArgs = strings.Split(queryStringConfig.HashKeyArgs, ",")
+	}
+	enable := queryStringConfig.Enable
+	if enable == "" {
+		enable = "off"
+	}
+	if err := d.Set("parameter_filter_config", []map[string]interface{}{{
+		"enable":        enable,
+		"hash_key_args": hashKeyArgs,
+	}}); err != nil {
+		return fmt.Errorf("Setting parameter_filter_config got an error: %#v", err)
 	}
 
 	errorPageConfig := configs.ErrorPageConfig
-	if _, ok := d.GetOk("page_404_config"); ok {
-		config := make([]map[string]interface{}, 1)
-		config[0] = map[string]interface{}{
-			"page_type":       errorPageConfig.PageType,
-			"error_code":      errorPageConfig.ErrorCode,
-			"custom_page_url": errorPageConfig.CustomPageUrl,
-		}
-		if errorPageConfig.PageType == "" {
-			config[0]["page_type"] = "default"
-		}
-		d.Set("page_404_config", config)
+	pageType := errorPageConfig.PageType
+	if pageType == "" {
+		pageType = "default"
+	}
+	if err := d.Set("page_404_config", []map[string]interface{}{{
+		"page_type":       pageType,
+		"error_code":      errorPageConfig.ErrorCode,
+		"custom_page_url": errorPageConfig.CustomPageUrl,
+	}}); err != nil {
+		return fmt.Errorf("Setting page_404_config got an error: %#v", err)
 	}
 
+	// The refer config only exists after a refer list is set.
 	referConfig := configs.RefererConfig
-	if _, ok := d.GetOk("refer_config"); ok {
-		config := make([]map[string]interface{}, 1)
-		config[0] = map[string]interface{}{
+	var referConfigs []map[string]interface{}
+	if referConfig.ReferList != "" {
+		referConfigs = append(referConfigs, map[string]interface{}{
 			"refer_type":  referConfig.ReferType,
 			"refer_list":  strings.Split(referConfig.ReferList, ","),
 			"allow_empty": referConfig.AllowEmpty,
-		}
-		d.Set("refer_config", config)
+		})
+	}
+	if err := d.Set("refer_config", referConfigs); err != nil {
+		return fmt.Errorf("Setting refer_config got an error: %#v", err)
 	}
 
 	authConfig := configs.ReqAuthConfig
@@ -40,6 +40,11 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -492,7 +497,7 @@ func resourceAlicloudCSKubernetesCreate(d *schema.ResourceData, meta interface{}
 
 	if err := invoker.Run(func() error {
 		_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
-			return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, 3600)
+			return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 		})
 		return err
 	}); err != nil {
@@ -513,7 +518,7 @@ func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}
 
 		args := &cs.KubernetesClusterResizeArgs{
 			DisableRollback: true,
-			TimeoutMins:     60,
+			TimeoutMins:     int64(d.Timeout(schema.TimeoutUpdate).Minutes()),
 			LoginPassword:   d.Get("password").(string),
 		}
 
@@ -539,7 +544,7 @@ func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}
 
 		if err := invoker.Run(func() error {
 			_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
-				return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, 3600)
+				return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
 			})
 			return err
 		}); err != nil {
@@ -847,7 +852,7 @@ func resourceAlicloudCSKubernetesDelete(d *schema.ResourceData, meta interface{}
 	client := meta.(*connectivity.AliyunClient)
 	invoker := NewInvoker()
 	var cluster cs.ClusterType
-	return resource.Retry(15*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := invoker.Run(func() error {
 			_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 				return nil, csClient.DeleteCluster(d.Id())
@@ -979,7 +984,7 @@ func buildKubernetesArgs(d *schema.ResourceData, meta interface{}) (*cs.Kubernet
 		Name:                     clusterName,
 		ClusterType:              "Kubernetes",
 		DisableRollback:          true,
-		TimeoutMins:              60,
+		TimeoutMins:              int64(d.Timeout(schema.TimeoutCreate).Minutes()),
 		MasterInstanceType:       masterInstanceType,
 		WorkerInstanceType:       workerInstanceType,
 		VPCID:                    vpcId,
@@ -1080,7 +1085,7 @@ func buildKubernetesMultiAZArgs(d *schema.ResourceData, meta interface{}) (*cs.K
 		Name:                     clusterName,
 		ClusterType:              "Kubernetes",
 		DisableRollback:          true,
-		TimeoutMins:              60,
+		TimeoutMins:              int64(d.Timeout(schema.TimeoutCreate).Minutes()),
 		MultiAZ:                  true,
 		MasterInstanceTypeA:      masterInstanceTypes[0],
 		MasterInstanceTypeB:      masterInstanceTypes[1],
@@ -0,0 +1,646 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/common"
+	"github.com/denverdino/aliyungo/cs"
+	"github.com/denverdino/aliyungo/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+const KubernetesNodePoolDefaultTimeoutInMinute = 30
+
+func resourceAlicloudCSKubernetesNodePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCSKubernetesNodePoolCreate,
+		Read:   resourceAlicloudCSKubernetesNodePoolRead,
+		Update: resourceAlicloudCSKubernetesNodePoolUpdate,
+		Delete: resourceAlicloudCSKubernetesNodePoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(KubernetesNodePoolDefaultTimeoutInMinute * time.Minute),
+			Update: schema.DefaultTimeout(KubernetesNodePoolDefaultTimeoutInMinute * time.Minute),
+			Delete: schema.DefaultTimeout(KubernetesNodePoolDefaultTimeoutInMinute * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateContainerName,
+			},
+			"vswitch_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				MinItems: 1,
+			},
+			"instance_types": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				MinItems: 1,
+				MaxItems: 10,
+			},
+			"node_count": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validateIntegerInRange(0, 1000),
+				ConflictsWith: []string{"scaling_config"},
+			},
+			"scaling_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntegerInRange(0, 1000),
+						},
+						"max_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntegerInRange(0, 1000),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "cpu",
+							ValidateFunc: validateAllowedStringValue([]string{"cpu", "gpu", "gpushare", "spot"}),
+						},
+					},
+				},
+				ConflictsWith: []string{"node_count"},
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"key_name"},
+			},
+			"key_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"password"},
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"image_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: imageIdSuppressFunc,
+			},
+			"system_disk_category": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  DiskCloudEfficiency,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(DiskCloudEfficiency), string(DiskCloudSSD)}),
+			},
+			"system_disk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      40,
+				ValidateFunc: validateIntegerInRange(20, 32768),
+			},
+			"data_disks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  DiskCloudEfficiency,
+							ValidateFunc: validateAllowedStringValue([]string{
+								string(DiskCloudEfficiency), string(DiskCloudSSD)}),
+						},
+						"size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      40,
+							ValidateFunc: validateIntegerInRange(20, 32768),
+						},
+					},
+				},
+			},
+			"spot_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  NoSpot,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(NoSpot), string(SpotWithPriceLimit), string(SpotAsPriceGo)}),
+			},
+			"spot_price_limit": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"price_limit": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+					},
+				},
+				DiffSuppressFunc: csKubernetesNodePoolSpotPriceLimitDiffSuppressFunc,
+			},
+			"labels": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"taints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"effect": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "NoSchedule",
+							ValidateFunc: validateAllowedStringValue([]string{"NoSchedule", "NoExecute", "PreferNoSchedule"}),
+						},
+					},
+				},
+			},
+			"scaling_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCSKubernetesNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	csService := CsService{client}
+	invoker := NewInvoker()
+
+	clusterId := d.Get("cluster_id").(string)
+	_, scaling := d.GetOk("scaling_config")
+
+	args, err := buildKubernetesNodePoolArgs(d, client)
+	if err != nil {
+		return err
+	}
+	request := &CreateNodePoolArgs{
+		RegionId:                 common.Region(client.RegionId),
+		NodePoolInfo:             args.NodePoolInfo,
+		NodePoolScalingGroup:     args.NodePoolScalingGroup,
+		NodePoolKubernetesConfig: args.NodePoolKubernetesConfig,
+		NodePoolAutoScaling:      args.NodePoolAutoScaling,
+	}
+	// The initial nodes of the auto scaling node pool are decided by its min_size.
+	if !scaling {
+		request.Count = int64(d.Get("node_count").(int))
+	}
+
+	if err := invoker.Run(func() error {
+		raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
+			response := &CreateNodePoolResponse{}
+			err := csClient.Invoke(request.RegionId, http.MethodPost, fmt.Sprintf("/clusters/%s/nodepools", clusterId), nil, request, response)
+			return response, err
+		})
+		if err != nil {
+			return err
+		}
+		response, _ := raw.(*CreateNodePoolResponse)
+		d.SetId(fmt.Sprintf("%s%s%s", clusterId, COLON_SEPARATED, response.NodePoolId))
+		return nil
+	}); err != nil {
+		return fmt.Errorf("Creating Kubernetes Node Pool got an error: %#v", err)
+	}
+
+	if err := csService.WaitForCsKubernetesNodePool(clusterId, strings.Split(d.Id(), COLON_SEPARATED)[1], NodePoolActive, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("Waitting for Kubernetes Node Pool %#v got an error: %#v", NodePoolActive, err)
+	}
+
+	return resourceAlicloudCSKubernetesNodePoolRead(d, meta)
+}
+
+func resourceAlicloudCSKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	csService := CsService{client}
+
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(split) < 2 {
+		return fmt.Errorf("Invalid resource ID %s. Please check it and try again.", d.Id())
+	}
+	nodePool, err := csService.DescribeCsKubernetesNodePool(split[0], split[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("cluster_id", split[0])
+	d.Set("name", nodePool.Name)
+	d.Set("vswitch_ids", nodePool.VswitchIds)
+	d.Set("instance_types", nodePool.InstanceTypes)
+	d.Set("node_count", nodePool.TotalNodes)
+	d.Set("key_name", nodePool.KeyPair)
+	d.Set("security_group_id", nodePool.SecurityGroupId)
+	d.Set("image_id", nodePool.ImageId)
+	d.Set("system_disk_category", nodePool.SystemDiskCategory)
+	d.Set("system_disk_size", nodePool.SystemDiskSize)
+	d.Set("spot_strategy", nodePool.SpotStrategy)
+	d.Set("scaling_group_id", nodePool.ScalingGroupId)
+	d.Set("vpc_id", nodePool.VpcId)
+
+	var dataDisks []map[string]interface{}
+	for _, disk := range nodePool.DataDisks {
+		dataDisks = append(dataDisks, map[string]interface{}{
+			"category": disk.Category,
+			"size":     disk.Size,
+		})
+	}
+	if err := d.Set("data_disks", dataDisks); err != nil {
+		return fmt.Errorf("Setting data_disks got an error: %#v", err)
+	}
+
+	var spotPriceLimit []map[string]interface{}
+	for _, price := range nodePool.SpotPriceLimit {
+		limit, err := strconv.ParseFloat(price.PriceLimit, 64)
+		if err != nil {
+			return fmt.Errorf("Parsing the spot price limit %s got an error: %#v", price.PriceLimit, err)
+		}
+		spotPriceLimit = append(spotPriceLimit, map[string]interface{}{
+			"instance_type": price.InstanceType,
+			"price_limit":   limit,
+		})
+	}
+	if err := d.Set("spot_price_limit", spotPriceLimit); err != nil {
+		return fmt.Errorf("Setting spot_price_limit got an error: %#v", err)
+	}
+
+	var labels []map[string]interface{}
+	for _, label := range nodePool.Labels {
+		labels = append(labels, map[string]interface{}{
+			"key":   label.Key,
+			"value": label.Value,
+		})
+	}
+	if err := d.Set("labels", labels); err != nil {
+		return fmt.Errorf("Setting labels got an error: %#v", err)
+	}
+
+	var taints []map[string]interface{}
+	for _, taint := range nodePool.Taints {
+		taints = append(taints, map[string]interface{}{
+			"key":    taint.Key,
+			"value":  taint.Value,
+			"effect": taint.Effect,
+		})
+	}
+	if err := d.Set("taints", taints); err != nil {
+		return fmt.Errorf("Setting taints got an error: %#v", err)
+	}
+
+	var scalingConfig []map[string]interface{}
+	if nodePool.NodePoolAutoScaling.Enable {
+		scalingConfig = append(scalingConfig, map[string]interface{}{
+			"min_size": nodePool.MinInstances,
+			"max_size": nodePool.MaxInstances,
+			"type":     nodePool.NodePoolAutoScaling.Type,
+		})
+	}
+	if err := d.Set("scaling_config", scalingConfig); err != nil {
+		return fmt.Errorf("Setting scaling_config got an error: %#v", err)
+	}
+
+	return nil
+}
+
+func resourceAlicloudCSKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	csService := CsService{client}
+	invoker := NewInvoker()
+	d.Partial(true)
+
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(split) < 2 {
+		return fmt.Errorf("Invalid resource ID %s. Please check it and try again.", d.Id())
+	}
+	clusterId, nodePoolId := split[0], split[1]
+	timeout := int(d.Timeout(schema.TimeoutUpdate).Seconds())
+
+	updated := false
+	for _, key := range []string{"name", "vswitch_ids", "instance_types", "scaling_config", "password", "key_name", "image_id",
+		"system_disk_category", "system_disk_size", "data_disks", "spot_strategy", "spot_price_limit", "labels", "taints"} {
+		updated = updated || d.HasChange(key)
+	}
+	if updated {
+		args, err := buildKubernetesNodePoolArgs(d, client)
+		if err != nil {
+			return err
+		}
+		if err := invoker.Run(func() error {
+			_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
+				return nil, csClient.Invoke(args.RegionId, http.MethodPut, fmt.Sprintf("/clusters/%s/nodepools/%s", clusterId, nodePoolId), nil, args, nil)
+			})
+			return err
+		}); err != nil {
+			return fmt.Errorf("UpdateNodePool %s got an error: %#v", d.Id(), err)
+		}
+		if err := csService.WaitForCsKubernetesNodePool(clusterId, nodePoolId, NodePoolActive, timeout); err != nil {
+			return fmt.Errorf("Waitting for Kubernetes Node Pool %#v got an error: %#v", NodePoolActive, err)
+		}
+		d.SetPartial("name")
+		d.SetPartial("vswitch_ids")
+		d.SetPartial("instance_types")
+		d.SetPartial("scaling_config")
+		d.SetPartial("password")
+		d.SetPartial("key_name")
+		d.SetPartial("image_id")
+		d.SetPartial("system_disk_category")
+		d.SetPartial("system_disk_size")
+		d.SetPartial("data_disks")
+		d.SetPartial("spot_strategy")
+		d.SetPartial("spot_price_limit")
+		d.SetPartial("labels")
+		d.SetPartial("taints")
+	}
+
+	// The nodes are scaled by the auto scaling when scaling_config is set.
+	if _, scaling := d.GetOk("scaling_config"); !scaling && d.HasChange("node_count") {
+		nodePool, err := csService.DescribeCsKubernetesNodePool(clusterId, nodePoolId)
+		if err != nil {
+			return err
+		}
+		if err := scaleKubernetesNodePool(d, meta, nodePool.TotalNodes, d.Get("node_count").(int)); err != nil {
+			return err
+		}
+		if err := csService.WaitForCsKubernetesNodePool(clusterId, nodePoolId, NodePoolActive, timeout); err != nil {
+			return fmt.Errorf("Waitting for Kubernetes Node Pool %#v got an error: %#v", NodePoolActive, err)
+		}
+		d.SetPartial("node_count")
+	}
+	d.Partial(false)
+
+	return resourceAlicloudCSKubernetesNodePoolRead(d, meta)
+}
+
+func resourceAlicloudCSKubernetesNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	csService := CsService{client}
+	invoker := NewInvoker()
+
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(split) < 2 {
+		return fmt.Errorf("Invalid resource ID %s. Please check it and try again.", d.Id())
+	}
+	clusterId, nodePoolId := split[0], split[1]
+	timeout := int(d.Timeout(schema.TimeoutDelete).Seconds())
+
+	nodePool, err := csService.DescribeCsKubernetesNodePool(clusterId, nodePoolId)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	// The node pool can not be deleted until all of its nodes are removed, and the auto scaling has to be
+	// disabled first so that the removed nodes are not added back.
+	if nodePool.NodePoolAutoScaling.Enable {
+		args := &UpdateNodePoolArgs{
+			RegionId:                 common.Region(client.RegionId),
+			NodePoolInfo:             nodePool.NodePoolInfo,
+			NodePoolScalingGroup:     nodePool.NodePoolScalingGroup,
+			NodePoolKubernetesConfig: nodePool.NodePoolKubernetesConfig,
+			NodePoolAutoScaling:      NodePoolAutoScaling{Enable: false},
+		}
+		if err := invoker.Run(func() error {
+			_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
+				return nil, csClient.Invoke(args.RegionId, http.MethodPut, fmt.Sprintf("/clusters/%s/nodepools/%s", clusterId, nodePoolId), nil, args, nil)
+			})
+			return err
+		}); err != nil {
+			return fmt.Errorf("Disabling the auto scaling of Kubernetes Node Pool %s got an error: %#v", d.Id(), err)
+		}
+		if err := csService.WaitForCsKubernetesNodePool(clusterId, nodePoolId, NodePoolActive, timeout); err != nil {
+			return fmt.Errorf("Waitting for Kubernetes Node Pool %#v got an error: %#v", NodePoolActive, err)
+		}
+	}
+	if nodePool.TotalNodes > 0 {
+		if err := scaleKubernetesNodePool(d, meta, nodePool.TotalNodes, 0); err != nil {
+			return err
+		}
+		if err := csService.WaitForCsKubernetesNodePool(clusterId, nodePoolId, NodePoolActive, timeout); err != nil {
+			return fmt.Errorf("Waitting for Kubernetes Node Pool %#v got an error: %#v", NodePoolActive, err)
+		}
+	}
+
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := invoker.Run(func() error {
+			_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
+				return nil, csClient.Invoke("", http.MethodDelete, fmt.Sprintf("/clusters/%s/nodepools/%s", clusterId, nodePoolId), nil, nil, nil)
+			})
+			return err
+		}); err != nil {
+			if IsExceptedErrors(err, []string{ErrorClusterNotFound, ErrorNodePoolNotFound}) {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("Delete Kubernetes Node Pool timeout and get an error: %#v.", err))
+		}
+
+		if _, err := csService.DescribeCsKubernetesNodePool(clusterId, nodePoolId); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("Delete Kubernetes Node Pool %s timeout.", d.Id()))
+	})
+}
+
+// scaleKubernetesNodePool scales out the node pool by the CS API, or removes and releases the newest nodes to scale it in.
+func scaleKubernetesNodePool(d *schema.ResourceData, meta interface{}, current, expected int) error {
+	client := meta.(*connectivity.AliyunClient)
+	csService := CsService{client}
+	invoker := NewInvoker()
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+	clusterId, nodePoolId := split[0], split[1]
+
+	if expected > current {
+		args := &ScaleOutNodePoolArgs{Count: int64(expected - current)}
+		if err := invoker.Run(func() error {
+			_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
+				return nil, csClient.Invoke("", http.MethodPost, fmt.Sprintf("/clusters/%s/nodepools/%s", clusterId, nodePoolId), nil, args, nil)
+			})
+			return err
+		}); err != nil {
+			return fmt.Errorf("ScaleOutNodePool %s got an error: %#v", d.Id(), err)
+		}
+		return nil
+	}
+	if expected == current {
+		return nil
+	}
+
+	nodes, err := csService.DescribeCsKubernetesNodePoolNodes(clusterId, nodePoolId)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].CreationTime > nodes[j].CreationTime
+	})
+	var names []string
+	for i := 0; i < len(nodes) && i < current-expected; i++ {
+		names = append(names, nodes[i].NodeName)
+	}
+	if len(names) < 1 {
+		return nil
+	}
+
+	args := &RemoveClusterNodesArgs{
+		Nodes:       names,
+		ReleaseNode: true,
+		DrainNode:   true,
+	}
+	if err := invoker.Run(func() error {
+		_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
+			return nil, csClient.Invoke("", http.MethodPost, fmt.Sprintf("/api/v2/clusters/%s/nodes/remove", clusterId), nil, args, nil)
+		})
+		return err
+	}); err != nil {
+		return fmt.Errorf("RemoveClusterNodes %s from Kubernetes Node Pool %s got an error: %#v", strings.Join(names, ","), d.Id(), err)
+	}
+	return nil
+}
+
+func buildKubernetesNodePoolArgs(d *schema.ResourceData, client *connectivity.AliyunClient) (*UpdateNodePoolArgs, error) {
+	args := &UpdateNodePoolArgs{
+		RegionId: common.Region(client.RegionId),
+		NodePoolInfo: NodePoolInfo{
+			Name: d.Get("name").(string),
+		},
+		NodePoolScalingGroup: NodePoolScalingGroup{
+			VswitchIds:         expandStringList(d.Get("vswitch_ids").([]interface{})),
+			InstanceTypes:      expandStringList(d.Get("instance_types").([]interface{})),
+			LoginPassword:      d.Get("password").(string),
+			KeyPair:            d.Get("key_name").(string),
+			SecurityGroupId:    d.Get("security_group_id").(string),
+			SystemDiskCategory: ecs.DiskCategory(d.Get("system_disk_category").(string)),
+			SystemDiskSize:     int64(d.Get("system_disk_size").(int)),
+			ImageId:            d.Get("image_id").(string),
+			InstanceChargeType: string(PostPaid),
+			SpotStrategy:       d.Get("spot_strategy").(string),
+			DataDisks:          []NodePoolDataDisk{},
+			SpotPriceLimit:     []NodePoolSpotPrice{},
+		},
+		NodePoolKubernetesConfig: NodePoolKubernetesConfig{
+			Labels: []NodePoolLabel{},
+			Taints: []NodePoolTaint{},
+		},
+	}
+
+	for _, v := range d.Get("data_disks").([]interface{}) {
+		disk := v.(map[string]interface{})
+		args.DataDisks = append(args.DataDisks, NodePoolDataDisk{
+			Category: disk["category"].(string),
+			Size:     disk["size"].(int),
+		})
+	}
+	if args.SpotStrategy == string(SpotWithPriceLimit) {
+		if len(d.Get("spot_price_limit").([]interface{})) < 1 {
+			return nil, fmt.Errorf("spot_price_limit is required when spot_strategy is %s.", SpotWithPriceLimit)
+		}
+		for _, v := range d.Get("spot_price_limit").([]interface{}) {
+			price := v.(map[string]interface{})
+			args.SpotPriceLimit = append(args.SpotPriceLimit, NodePoolSpotPrice{
+				InstanceType: price["instance_type"].(string),
+				PriceLimit:   strconv.FormatFloat(price["price_limit"].(float64), 'f', -1, 64),
+			})
+		}
+	}
+	for _, v := range d.Get("labels").([]interface{}) {
+		label := v.(map[string]interface{})
+		args.Labels = append(args.Labels, NodePoolLabel{
+			Key:   label["key"].(string),
+			Value: label["value"].(string),
+		})
+	}
+	for _, v := range d.Get("taints").([]interface{}) {
+		taint := v.(map[string]interface{})
+		args.Taints = append(args.Taints, NodePoolTaint{
+			Key:    taint["key"].(string),
+			Value:  taint["value"].(string),
+			Effect: taint["effect"].(string),
+		})
+	}
+	if v, ok := d.GetOk("scaling_config"); ok && len(v.([]interface{})) > 0 {
+		scaling := v.([]interface{})[0].(map[string]interface{})
+		args.NodePoolAutoScaling = NodePoolAutoScaling{
+			Enable:       true,
+			MinInstances: int64(scaling["min_size"].(int)),
+			MaxInstances: int64(scaling["max_size"].(int)),
+			Type:         scaling["type"].(string),
+		}
+		if args.MinInstances > args.MaxInstances {
+			return nil, fmt.Errorf("The min_size %d of scaling_config can not be greater than its max_size %d.", args.MinInstances, args.MaxInstances)
+		}
+	}
+	return args, nil
+}
+
+func csKubernetesNodePoolSpotPriceLimitDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return d.Get("spot_strategy").(string) != string(SpotWithPriceLimit)
+}
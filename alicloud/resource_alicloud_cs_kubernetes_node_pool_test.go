@@ -0,0 +1,220 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudCSKubernetesNodePool_basic(t *testing.T) {
+	var nodePool NodePoolDetail
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheckWithRegions(t, true, connectivity.ManagedKubernetesSupportedRegions) },
+
+		IDRefreshName: "alicloud_cs_kubernetes_node_pool.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesNodePool(1, `
+  labels {
+    key = "pool"
+    value = "tf"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesNodePoolExists("alicloud_cs_kubernetes_node_pool.foo", &nodePool),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "name", "tf-testAccKubernetesNodePool"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "node_count", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "instance_types.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "vswitch_ids.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "system_disk_category", "cloud_efficiency"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "labels.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "labels.0.key", "pool"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "taints.#", "0"),
+					resource.TestCheckResourceAttrSet("alicloud_cs_kubernetes_node_pool.foo", "scaling_group_id"),
+					resource.TestCheckResourceAttrSet("alicloud_cs_kubernetes_node_pool.foo", "security_group_id"),
+				),
+			},
+			{
+				Config: testAccKubernetesNodePool(2, `
+  labels {
+    key = "pool"
+    value = "tf"
+  }
+  taints {
+    key = "dedicated"
+    value = "tf"
+    effect = "NoSchedule"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesNodePoolExists("alicloud_cs_kubernetes_node_pool.foo", &nodePool),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "node_count", "2"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "labels.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "taints.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "taints.0.effect", "NoSchedule"),
+				),
+			},
+			{
+				Config: testAccKubernetesNodePool(1, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesNodePoolExists("alicloud_cs_kubernetes_node_pool.foo", &nodePool),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "node_count", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "labels.#", "0"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "taints.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudCSKubernetesNodePool_scalingConfig(t *testing.T) {
+	var nodePool NodePoolDetail
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheckWithRegions(t, true, connectivity.ManagedKubernetesSupportedRegions) },
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesNodePoolScalingConfig(1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesNodePoolExists("alicloud_cs_kubernetes_node_pool.foo", &nodePool),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "scaling_config.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "scaling_config.0.min_size", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "scaling_config.0.max_size", "3"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "scaling_config.0.type", "cpu"),
+				),
+			},
+			{
+				Config: testAccKubernetesNodePoolScalingConfig(2, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesNodePoolExists("alicloud_cs_kubernetes_node_pool.foo", &nodePool),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "scaling_config.0.min_size", "2"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.foo", "scaling_config.0.max_size", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesNodePoolExists(name string, nodePool *NodePoolDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kubernetes Node Pool ID is set")
+		}
+
+		client := testAccProvider.Meta().(*connectivity.AliyunClient)
+		csService := CsService{client}
+		split := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		detail, err := csService.DescribeCsKubernetesNodePool(split[0], split[1])
+		if err != nil {
+			return err
+		}
+		*nodePool = *detail
+		return nil
+	}
+}
+
+func testAccCheckKubernetesNodePoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AliyunClient)
+	csService := CsService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cs_kubernetes_node_pool" {
+			continue
+		}
+
+		split := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		if _, err := csService.DescribeCsKubernetesNodePool(split[0], split[1]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Kubernetes Node Pool %s still exists.", rs.Primary.ID)
+	}
+
+	return testAccCheckManagedKubernetesClusterDestroy(s)
+}
+
+func testAccKubernetesNodePool(count int, extra string) string {
+	return fmt.Sprintf(`%s
+resource "alicloud_cs_kubernetes_node_pool" "foo" {
+  cluster_id = "${alicloud_cs_managed_kubernetes.k8s.id}"
+  name = "${var.name}"
+  vswitch_ids = ["${alicloud_vswitch.foo.id}"]
+  instance_types = ["${data.alicloud_instance_types.default.instance_types.0.id}"]
+  node_count = %d
+  password = "Test12345"
+%s
+}
+`, testAccKubernetesNodePoolCluster, count, extra)
+}
+
+func testAccKubernetesNodePoolScalingConfig(min, max int) string {
+	return fmt.Sprintf(`%s
+resource "alicloud_cs_kubernetes_node_pool" "foo" {
+  cluster_id = "${alicloud_cs_managed_kubernetes.k8s.id}"
+  name = "${var.name}"
+  vswitch_ids = ["${alicloud_vswitch.foo.id}"]
+  instance_types = ["${data.alicloud_instance_types.default.instance_types.0.id}"]
+  password = "Test12345"
+  scaling_config {
+    min_size = %d
+    max_size = %d
+  }
+}
+`, testAccKubernetesNodePoolCluster, min, max)
+}
+
+const testAccKubernetesNodePoolCluster = `
+variable "name" {
+	default = "tf-testAccKubernetesNodePool"
+}
+
+data "alicloud_zones" main {
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_types" "default" {
+	availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+	cpu_core_count = 2
+	memory_size = 4
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "${var.name}"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  name = "${var.name}"
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "10.1.1.0/24"
+  availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+}
+
+resource "alicloud_cs_managed_kubernetes" "k8s" {
+  name_prefix = "${var.name}"
+  availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+  vswitch_ids = ["${alicloud_vswitch.foo.id}"]
+  new_nat_gateway = true
+  worker_instance_types = ["${data.alicloud_instance_types.default.instance_types.0.id}"]
+  worker_numbers = [2]
+  password = "Test12345"
+  pod_cidr = "172.20.0.0/16"
+  service_cidr = "172.21.0.0/20"
+}
+`
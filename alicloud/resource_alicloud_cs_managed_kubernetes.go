@@ -29,6 +29,11 @@ func resourceAlicloudCSManagedKubernetes() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ManagedKubernetesCreationDefaultTimeoutInMinute * time.Minute),
+			Update: schema.DefaultTimeout(ManagedKubernetesCreationDefaultTimeoutInMinute * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -267,7 +272,7 @@ func resourceAlicloudCSManagedKubernetesCreate(d *schema.ResourceData, meta inte
 
 	if err := invoker.Run(func() error {
 		_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
-			return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, 3600)
+			return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 		})
 		return err
 	}); err != nil {
@@ -290,7 +295,7 @@ func resourceAlicloudCSManagedKubernetesUpdate(d *schema.ResourceData, meta inte
 		// When cluster was created using password, LoginPassword is required to resize.
 		args := &cs.KubernetesClusterResizeArgs{
 			DisableRollback: true,
-			TimeoutMins:     ManagedKubernetesCreationDefaultTimeoutInMinute,
+			TimeoutMins:     int64(d.Timeout(schema.TimeoutUpdate).Minutes()),
 			LoginPassword:   d.Get("password").(string),
 		}
 
@@ -307,7 +312,7 @@ func resourceAlicloudCSManagedKubernetesUpdate(d *schema.ResourceData, meta inte
 
 		if err := invoker.Run(func() error {
 			_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
-				return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, 3600)
+				return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
 			})
 			return err
 		}); err != nil {
@@ -521,7 +526,7 @@ func resourceAlicloudCSManagedKubernetesDelete(d *schema.ResourceData, meta inte
 	client := meta.(*connectivity.AliyunClient)
 	invoker := NewInvoker()
 	var cluster cs.ClusterType
-	return resource.Retry(15*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := invoker.Run(func() error {
 			_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 				return nil, csClient.DeleteCluster(d.Id())
@@ -619,7 +624,7 @@ func buildManagedKubernetesArgs(d *schema.ResourceData, meta interface{}) (*cs.K
 		Name:                     clusterName,
 		ClusterType:              "ManagedKubernetes",
 		DisableRollback:          true,
-		TimeoutMins:              ManagedKubernetesCreationDefaultTimeoutInMinute,
+		TimeoutMins:              int64(d.Timeout(schema.TimeoutCreate).Minutes()),
 		WorkerInstanceType:       workerInstanceType,
 		VPCID:                    vpcId,
 		VSwitchId:                vswitchID,
@@ -24,6 +24,13 @@ func resourceAlicloudDBInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: resourceAlicloudDBInstanceCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"engine": {
@@ -36,7 +43,6 @@ func resourceAlicloudDBInstance() *schema.Resource {
 				Type: schema.TypeString,
 				// Remove this limitation and refer to https://www.alibabacloud.com/help/doc-detail/26228.htm each time
 				//ValidateFunc: validateAllowedStringValue([]string{"5.5", "5.6", "5.7", "2008r2", "2012", "9.4", "9.3", "10.0"}),
-				ForceNew: true,
 				Required: true,
 			},
 			"db_instance_class": {
@@ -221,10 +227,113 @@ func resourceAlicloudDBInstance() *schema.Resource {
 				},
 				Deprecated: "Field 'db_mappings' has been deprecated from provider version 1.5.0. New resource 'alicloud_db_database' replaces it.",
 			},
+
+			"parameters": {
+				Type: schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+
+			"force_restart": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tde_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(RdsSecurityEnabled)}),
+			},
+
+			"encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ssl_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(RdsSecurityEnabled), string(RdsSecurityDisabled)}),
+			},
+
+			"ssl_connection_string": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ssl_ca_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(SSLCATypeAliyun), string(SSLCATypeCustom)}),
+			},
+
+			"server_cert": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"server_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"ssl_expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sql_collector_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(RdsSecurityEnabled), string(RdsSecurityDisabled)}),
+			},
+
+			"sql_collector_retention": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedIntValue(SQL_COLLECTOR_RETENTION),
+			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
+func resourceAlicloudDBInstanceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// The engine version can only be upgraded in place, and the instance is replaced for other changes of it.
+	if d.Id() != "" && d.HasChange("engine_version") {
+		o, n := d.GetChange("engine_version")
+		if !rdsEngineVersionUpgradable(Engine(d.Get("engine").(string)), o.(string), n.(string)) {
+			if err := d.ForceNew("engine_version"); err != nil {
+				return err
+			}
+		}
+	}
+	return customizeDiffTagsAll(d, meta)
+}
+
 func resourceAlicloudDBInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	rdsService := RdsService{client}
@@ -245,10 +354,22 @@ func resourceAlicloudDBInstanceCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(resp.DBInstanceId)
 
 	// wait instance status change from Creating to running
-	if err := rdsService.WaitForDBInstance(d.Id(), Running, DefaultLongTimeout); err != nil {
+	if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
 	}
 
+	if err := setTags(client, &rdsService, TagResourceDBInstance, d); err != nil {
+		return err
+	}
+
+	if err := rdsService.ModifyParameters(d, "parameters", d.Get("force_restart").(bool), int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return err
+	}
+
+	if err := updateDBInstanceSecurity(d, meta, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return err
+	}
+
 	return resourceAlicloudDBInstanceRead(d, meta)
 }
 
@@ -257,6 +378,12 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 	rdsService := RdsService{client}
 	d.Partial(true)
 
+	if err := setTags(client, &rdsService, TagResourceDBInstance, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
 	if d.HasChange("instance_name") {
 		request := rds.CreateModifyDBInstanceDescriptionRequest()
 		request.DBInstanceId = d.Id()
@@ -286,6 +413,24 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		d.SetPartial("security_ips")
 	}
 
+	if d.HasChange("engine_version") {
+		if err := rdsService.UpgradeDBInstanceEngineVersion(d.Id(), d.Get("engine_version").(string), int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return err
+		}
+		d.SetPartial("engine_version")
+	}
+
+	if d.HasChange("parameters") {
+		if err := rdsService.ModifyParameters(d, "parameters", d.Get("force_restart").(bool), int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return err
+		}
+		d.SetPartial("parameters")
+	}
+
+	if err := updateDBInstanceSecurity(d, meta, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return err
+	}
+
 	update := false
 	request := rds.CreateModifyDBInstanceSpecRequest()
 	request.DBInstanceId = d.Id()
@@ -303,7 +448,7 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	if update {
 		// wait instance status is running before modifying
-		if err := rdsService.WaitForDBInstance(d.Id(), Running, 500); err != nil {
+		if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
 		}
 		_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
@@ -315,7 +460,7 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		d.SetPartial("instance_type")
 		d.SetPartial("instance_storage")
 		// wait instance status is running after modifying
-		if err := rdsService.WaitForDBInstance(d.Id(), Running, 1800); err != nil {
+		if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
 		}
 	}
@@ -356,6 +501,18 @@ func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("connection_string", instance.ConnectionString)
 	d.Set("instance_name", instance.DBInstanceDescription)
 
+	if err := rdsService.RefreshParameters(d, "parameters"); err != nil {
+		return err
+	}
+
+	if err := readDBInstanceSecurity(d, meta); err != nil {
+		return err
+	}
+
+	if err := readTags(client, &rdsService, TagResourceDBInstance, d); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -377,7 +534,7 @@ func resourceAlicloudDBInstanceDelete(d *schema.ResourceData, meta interface{})
 	request := rds.CreateDeleteDBInstanceRequest()
 	request.DBInstanceId = d.Id()
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DeleteDBInstance(request)
 		})
@@ -479,3 +636,149 @@ func buildDBCreateRequest(d *schema.ResourceData, meta interface{}) (*rds.Create
 
 	return request, nil
 }
+
+// updateDBInstanceSecurity applies the changed TDE, SSL and SQL audit settings to the instance.
+func updateDBInstanceSecurity(d *schema.ResourceData, meta interface{}, timeout int) error {
+	client := meta.(*connectivity.AliyunClient)
+	rdsService := RdsService{client}
+
+	if d.HasChange("tde_status") || d.HasChange("encryption_key") {
+		o, n := d.GetChange("tde_status")
+		if o.(string) == string(RdsSecurityEnabled) {
+			if d.HasChange("encryption_key") {
+				return fmt.Errorf("'encryption_key' can not be changed after TDE is enabled.")
+			}
+		} else if n.(string) == string(RdsSecurityEnabled) {
+			if err := rdsService.ModifyDBInstanceTDE(d.Id(), d.Get("encryption_key").(string), timeout); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("tde_status")
+		d.SetPartial("encryption_key")
+	}
+
+	if d.HasChange("ssl_status") || d.HasChange("ssl_connection_string") || d.HasChange("ssl_ca_type") ||
+		d.HasChange("server_cert") || d.HasChange("server_key") {
+		o, n := d.GetChange("ssl_status")
+		var request *rds.ModifyDBInstanceSSLRequest
+		if n.(string) == string(RdsSecurityEnabled) {
+			req, err := buildDBInstanceSSLRequest(d, meta)
+			if err != nil {
+				return err
+			}
+			request = req
+		} else if n.(string) == string(RdsSecurityDisabled) && o.(string) == string(RdsSecurityEnabled) {
+			request = rds.CreateModifyDBInstanceSSLRequest()
+			request.DBInstanceId = d.Id()
+			request.QueryParams["SSLEnabled"] = "0"
+		}
+		if request != nil {
+			if err := rdsService.ModifyDBInstanceSSL(request, timeout); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("ssl_status")
+		d.SetPartial("ssl_connection_string")
+		d.SetPartial("ssl_ca_type")
+		d.SetPartial("server_cert")
+		d.SetPartial("server_key")
+	}
+
+	if d.HasChange("sql_collector_status") || d.HasChange("sql_collector_retention") {
+		if status := d.Get("sql_collector_status").(string); status != "" {
+			if err := rdsService.ModifySQLCollectorPolicy(d.Id(), RdsSecurityStatus(status), d.Get("sql_collector_retention").(int)); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("sql_collector_status")
+		d.SetPartial("sql_collector_retention")
+	}
+
+	return nil
+}
+
+// readDBInstanceSecurity reads the TDE, SSL and SQL audit settings of the instance. Each of them is only read
+// when it is managed, since not all the engines support them.
+func readDBInstanceSecurity(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	rdsService := RdsService{client}
+
+	if d.Get("tde_status").(string) != "" {
+		status, err := rdsService.DescribeDBInstanceTDE(d.Id())
+		if err != nil {
+			return fmt.Errorf("DescribeDBInstanceTDE got an error: %#v", err)
+		}
+		d.Set("tde_status", status)
+	}
+
+	if d.Get("ssl_status").(string) != "" {
+		ssl, err := rdsService.DescribeDBInstanceSSL(d.Id())
+		if err != nil {
+			return fmt.Errorf("DescribeDBInstanceSSL got an error: %#v", err)
+		}
+		if ssl.ConnectionString == "" {
+			d.Set("ssl_status", string(RdsSecurityDisabled))
+		} else {
+			d.Set("ssl_status", string(RdsSecurityEnabled))
+			d.Set("ssl_connection_string", ssl.ConnectionString)
+			if ssl.CAType != "" {
+				d.Set("ssl_ca_type", ssl.CAType)
+			}
+			// the returned certificate may differ from the configured one in the leading and trailing blanks
+			if ssl.ServerCert != "" && strings.TrimSpace(ssl.ServerCert) != strings.TrimSpace(d.Get("server_cert").(string)) {
+				d.Set("server_cert", ssl.ServerCert)
+			}
+		}
+		d.Set("ssl_expire_time", ssl.SSLExpireTime)
+	}
+
+	if d.Get("sql_collector_status").(string) != "" {
+		policy, err := rdsService.DescribeSQLCollectorPolicy(d.Id())
+		if err != nil {
+			return fmt.Errorf("DescribeSQLCollectorPolicy got an error: %#v", err)
+		}
+		status := RdsSecurityDisabled
+		if policy.SQLCollectorStatus == "Enable" || policy.SQLCollectorStatus == string(RdsSecurityEnabled) {
+			status = RdsSecurityEnabled
+		}
+		d.Set("sql_collector_status", string(status))
+		d.Set("sql_collector_retention", policy.StoragePeriod)
+	}
+
+	return nil
+}
+
+func buildDBInstanceSSLRequest(d *schema.ResourceData, meta interface{}) (*rds.ModifyDBInstanceSSLRequest, error) {
+	client := meta.(*connectivity.AliyunClient)
+	rdsService := RdsService{client}
+
+	request := rds.CreateModifyDBInstanceSSLRequest()
+	request.DBInstanceId = d.Id()
+	request.ConnectionString = d.Get("ssl_connection_string").(string)
+	if request.ConnectionString == "" {
+		// protect the internal connection by default
+		instance, err := rdsService.DescribeDBInstanceById(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err)
+		}
+		request.ConnectionString = instance.ConnectionString
+	}
+
+	// the following parameters are not supported by the SDK yet
+	request.QueryParams["SSLEnabled"] = "1"
+	caType := d.Get("ssl_ca_type").(string)
+	if caType == "" {
+		caType = string(SSLCATypeAliyun)
+	}
+	request.QueryParams["CAType"] = caType
+	if caType == string(SSLCATypeCustom) {
+		cert, key := d.Get("server_cert").(string), d.Get("server_key").(string)
+		if cert == "" || key == "" {
+			return nil, fmt.Errorf("'server_cert' and 'server_key' are required when 'ssl_ca_type' is %s.", SSLCATypeCustom)
+		}
+		request.QueryParams["ServerCert"] = cert
+		request.QueryParams["ServerKey"] = key
+	}
+
+	return request, nil
+}
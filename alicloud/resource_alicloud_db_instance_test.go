@@ -10,6 +10,7 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
@@ -281,6 +282,122 @@ func TestAccAlicloudDBInstance_upgradeClass(t *testing.T) {
 
 }
 
+func TestAccAlicloudDBInstance_parameters(t *testing.T) {
+	var instance rds.DBInstanceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_db_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBInstance_parameters("86400"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "parameters.#", "2"),
+				),
+			},
+
+			{
+				Config: testAccDBInstance_parameters("3600"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "parameters.#", "2"),
+				),
+			},
+		},
+	})
+
+}
+
+func TestAccAlicloudDBInstance_upgradeEngineVersion(t *testing.T) {
+	var instance rds.DBInstanceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_db_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBInstance_engineVersion("5.6"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "engine_version", "5.6"),
+				),
+			},
+
+			{
+				Config: testAccDBInstance_engineVersion("5.7"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "engine_version", "5.7"),
+				),
+			},
+		},
+	})
+
+}
+
+func TestAccAlicloudDBInstance_security(t *testing.T) {
+	var instance rds.DBInstanceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_db_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBInstance_security("Enabled", 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "tde_status", "Enabled"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "ssl_status", "Enabled"),
+					resource.TestCheckResourceAttrSet("alicloud_db_instance.foo", "ssl_connection_string"),
+					resource.TestCheckResourceAttrSet("alicloud_db_instance.foo", "ssl_expire_time"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "sql_collector_status", "Enabled"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "sql_collector_retention", "30"),
+				),
+			},
+
+			{
+				Config: testAccDBInstance_security("Disabled", 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "tde_status", "Enabled"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "ssl_status", "Disabled"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "sql_collector_status", "Enabled"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "sql_collector_retention", "180"),
+				),
+			},
+		},
+	})
+
+}
+
 func testAccCheckSecurityIpExists(n string, ips []map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -489,3 +606,93 @@ resource "alicloud_db_instance" "foo" {
 	instance_name = "${var.name}"
 }
 `
+
+func testAccDBInstance_parameters(timeout string) string {
+	return fmt.Sprintf(`
+variable "name" {
+	default = "tf-testAccDBInstance_parameters"
+}
+resource "alicloud_db_instance" "foo" {
+	engine = "MySQL"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.t1.small"
+	instance_storage = "20"
+	instance_name = "${var.name}"
+	parameters = [{
+		name = "innodb_large_prefix"
+		value = "ON"
+	},{
+		name = "interactive_timeout"
+		value = "%s"
+	}]
+}
+`, timeout)
+}
+
+func testAccDBInstance_engineVersion(version string) string {
+	return fmt.Sprintf(`
+variable "name" {
+	default = "tf-testAccDBInstance_engineVersion"
+}
+resource "alicloud_db_instance" "foo" {
+	engine = "MySQL"
+	engine_version = "%s"
+	instance_type = "rds.mysql.s2.large"
+	instance_storage = "20"
+	instance_name = "${var.name}"
+}
+`, version)
+}
+
+func testAccDBInstance_security(ssl string, retention int) string {
+	return fmt.Sprintf(`
+variable "name" {
+	default = "tf-testAccDBInstance_security"
+}
+resource "alicloud_kms_key" "foo" {
+	description = "${var.name}"
+	deletion_window_in_days = 7
+}
+resource "alicloud_db_instance" "foo" {
+	engine = "MySQL"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.s2.large"
+	instance_storage = "20"
+	instance_name = "${var.name}"
+	tde_status = "Enabled"
+	encryption_key = "${alicloud_kms_key.foo.id}"
+	ssl_status = "%s"
+	sql_collector_status = "Enabled"
+	sql_collector_retention = %d
+}
+`, ssl, retention)
+}
+
+func TestResourceAlicloudDBInstanceCustomizeDiff(t *testing.T) {
+	state := &terraform.InstanceState{ID: "rm-1", Attributes: map[string]string{
+		"engine": "MySQL", "engine_version": "5.6", "instance_type": "rds.mysql.t1.small", "instance_storage": "10",
+	}}
+	diff := func(engineVersion string) *terraform.InstanceDiff {
+		c, err := config.NewRawConfig(map[string]interface{}{
+			"engine": "MySQL", "engine_version": engineVersion, "instance_type": "rds.mysql.t1.small", "instance_storage": 10,
+		})
+		if err != nil {
+			t.Fatalf("creating the config got an error: %#v", err)
+		}
+		d, err := resourceAlicloudDBInstance().Diff(state, terraform.NewResourceConfig(c), &connectivity.AliyunClient{})
+		if err != nil {
+			t.Fatalf("diffing the instance got an error: %#v", err)
+		}
+		return d
+	}
+
+	if attr := diff("5.7").Attributes["engine_version"]; attr == nil || attr.RequiresNew {
+		t.Fatalf("expected the engine version to be upgraded in place, got %#v", attr)
+	}
+	if attr := diff("5.5").Attributes["engine_version"]; attr == nil || !attr.RequiresNew {
+		t.Fatalf("expected a downgrade of the engine version to replace the instance, got %#v", attr)
+	}
+	if attr := diff("8.0").Attributes["engine_version"]; attr == nil || !attr.RequiresNew {
+		t.Fatalf("expected an upgrade skipping a major version to replace the instance, got %#v", attr)
+	}
+}
@@ -0,0 +1,282 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudDBReadonlyInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDBReadonlyInstanceCreate,
+		Read:   resourceAlicloudDBReadonlyInstanceRead,
+		Update: resourceAlicloudDBReadonlyInstanceUpdate,
+		Delete: resourceAlicloudDBReadonlyInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"master_db_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"engine_version": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"instance_storage": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+			},
+
+			"instance_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDBInstanceName,
+			},
+
+			"connection_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDBReadonlyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	rdsService := RdsService{client}
+
+	request, err := buildDBReadonlyCreateRequest(d, meta)
+	if err != nil {
+		return err
+	}
+
+	// the master instance is changing while creating its read-only instances, so the creation is retried
+	invoker := NewInvoker()
+	invoker.AddCatcher(DBInstanceStatusCatcher)
+	var resp *rds.CreateReadOnlyDBInstanceResponse
+	if err := invoker.Run(func() error {
+		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return rdsClient.CreateReadOnlyDBInstance(request)
+		})
+		if err != nil {
+			return err
+		}
+		resp, _ = raw.(*rds.CreateReadOnlyDBInstanceResponse)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("Error creating Alicloud db readonly instance: %#v", err)
+	}
+	d.SetId(resp.DBInstanceId)
+
+	// wait instance status change from Creating to running
+	if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+	}
+
+	return resourceAlicloudDBReadonlyInstanceRead(d, meta)
+}
+
+func resourceAlicloudDBReadonlyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	rdsService := RdsService{client}
+	d.Partial(true)
+
+	if d.HasChange("instance_name") {
+		request := rds.CreateModifyDBInstanceDescriptionRequest()
+		request.DBInstanceId = d.Id()
+		request.DBInstanceDescription = d.Get("instance_name").(string)
+
+		_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return rdsClient.ModifyDBInstanceDescription(request)
+		})
+		if err != nil {
+			return fmt.Errorf("ModifyDBInstanceDescription got an error: %#v", err)
+		}
+		d.SetPartial("instance_name")
+	}
+
+	update := false
+	request := rds.CreateModifyDBInstanceSpecRequest()
+	request.DBInstanceId = d.Id()
+	request.PayType = string(Postpaid)
+
+	if d.HasChange("instance_type") {
+		request.DBInstanceClass = d.Get("instance_type").(string)
+		update = true
+	}
+
+	if d.HasChange("instance_storage") {
+		request.DBInstanceStorage = requests.NewInteger(d.Get("instance_storage").(int))
+		update = true
+	}
+
+	if update {
+		// wait instance status is running before modifying
+		if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		}
+		_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return rdsClient.ModifyDBInstanceSpec(request)
+		})
+		if err != nil {
+			return err
+		}
+		d.SetPartial("instance_type")
+		d.SetPartial("instance_storage")
+		// wait instance status is running after modifying
+		if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		}
+	}
+
+	d.Partial(false)
+	return resourceAlicloudDBReadonlyInstanceRead(d, meta)
+}
+
+func resourceAlicloudDBReadonlyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	rdsService := RdsService{client}
+
+	instance, err := rdsService.DescribeDBInstanceById(d.Id())
+	if err != nil {
+		if rdsService.NotFoundDBInstance(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err)
+	}
+
+	d.Set("master_db_instance_id", instance.MasterInstanceId)
+	d.Set("engine", instance.Engine)
+	d.Set("engine_version", instance.EngineVersion)
+	d.Set("instance_type", instance.DBInstanceClass)
+	d.Set("port", instance.Port)
+	d.Set("instance_storage", instance.DBInstanceStorage)
+	d.Set("zone_id", instance.ZoneId)
+	d.Set("vswitch_id", instance.VSwitchId)
+	d.Set("connection_string", instance.ConnectionString)
+	d.Set("instance_name", instance.DBInstanceDescription)
+
+	return nil
+}
+
+func resourceAlicloudDBReadonlyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	rdsService := RdsService{client}
+
+	request := rds.CreateDeleteDBInstanceRequest()
+	request.DBInstanceId = d.Id()
+
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return rdsClient.DeleteDBInstance(request)
+		})
+
+		if err != nil {
+			if rdsService.NotFoundDBInstance(err) {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("Delete DB readonly instance timeout and got an error: %#v.", err))
+		}
+
+		if _, err := rdsService.DescribeDBInstanceById(d.Id()); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Delete DB readonly instance %s timeout.", d.Id()))
+	})
+}
+
+func buildDBReadonlyCreateRequest(d *schema.ResourceData, meta interface{}) (*rds.CreateReadOnlyDBInstanceRequest, error) {
+	client := meta.(*connectivity.AliyunClient)
+	vpcService := VpcService{client}
+	request := rds.CreateCreateReadOnlyDBInstanceRequest()
+	request.RegionId = string(client.Region)
+	request.DBInstanceId = Trim(d.Get("master_db_instance_id").(string))
+	request.EngineVersion = Trim(d.Get("engine_version").(string))
+	request.DBInstanceStorage = requests.NewInteger(d.Get("instance_storage").(int))
+	request.DBInstanceClass = Trim(d.Get("instance_type").(string))
+	request.DBInstanceDescription = d.Get("instance_name").(string)
+	// read-only instances only support the Pay-As-You-Go billing method
+	request.PayType = string(Postpaid)
+
+	if zone, ok := d.GetOk("zone_id"); ok && Trim(zone.(string)) != "" {
+		request.ZoneId = Trim(zone.(string))
+	}
+
+	vswitchId := Trim(d.Get("vswitch_id").(string))
+
+	request.InstanceNetworkType = string(Classic)
+
+	if vswitchId != "" {
+		request.VSwitchId = vswitchId
+		request.InstanceNetworkType = strings.ToUpper(string(Vpc))
+
+		// check vswitchId in zone
+		vsw, err := vpcService.DescribeVswitch(vswitchId)
+		if err != nil {
+			return nil, fmt.Errorf("DescribeVSwitche got an error: %#v.", err)
+		}
+
+		if request.ZoneId == "" {
+			request.ZoneId = vsw.ZoneId
+		} else if request.ZoneId != vsw.ZoneId {
+			return nil, fmt.Errorf("The specified vswitch %s isn't in the zone %s.", vsw.VSwitchId, request.ZoneId)
+		}
+
+		request.VPCId = vsw.VpcId
+	}
+
+	request.ClientToken = buildClientToken("TF-CreateDBReadonlyInstance")
+
+	return request, nil
+}
@@ -0,0 +1,117 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudDBReadonlyInstance_vpc(t *testing.T) {
+	var instance rds.DBInstanceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_db_readonly_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDBReadonlyInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBReadonlyInstance_vpc(DatabaseCommonTestCase, "rds.mysql.t1.small", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_readonly_instance.foo", &instance),
+					resource.TestCheckResourceAttrPair(
+						"alicloud_db_readonly_instance.foo", "master_db_instance_id",
+						"alicloud_db_instance.foo", "id"),
+					resource.TestCheckResourceAttr("alicloud_db_readonly_instance.foo", "engine", "MySQL"),
+					resource.TestCheckResourceAttr("alicloud_db_readonly_instance.foo", "engine_version", "5.6"),
+					resource.TestCheckResourceAttr("alicloud_db_readonly_instance.foo", "instance_type", "rds.mysql.t1.small"),
+					resource.TestCheckResourceAttr("alicloud_db_readonly_instance.foo", "instance_storage", "20"),
+					resource.TestCheckResourceAttrSet("alicloud_db_readonly_instance.foo", "connection_string"),
+					resource.TestCheckResourceAttrSet("alicloud_db_readonly_instance.foo", "port"),
+				),
+			},
+
+			{
+				Config: testAccDBReadonlyInstance_vpc(DatabaseCommonTestCase, "rds.mysql.s1.small", 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_readonly_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_readonly_instance.foo", "instance_type", "rds.mysql.s1.small"),
+					resource.TestCheckResourceAttr("alicloud_db_readonly_instance.foo", "instance_storage", "30"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckDBReadonlyInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AliyunClient)
+	rdsService := RdsService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_db_readonly_instance" && rs.Type != "alicloud_db_instance" {
+			continue
+		}
+
+		ins, err := rdsService.DescribeDBInstanceById(rs.Primary.ID)
+
+		if ins != nil {
+			return fmt.Errorf("Error DB Instance still exist")
+		}
+
+		// Verify the error is what we want
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccDBReadonlyInstance_vpc(common, instanceType string, storage int) string {
+	return fmt.Sprintf(`
+	%s
+	variable "creation" {
+		default = "Rds"
+	}
+	variable "multi_az" {
+		default = "false"
+	}
+	variable "name" {
+		default = "tf-testAccDBReadonlyInstance_vpc"
+	}
+
+	resource "alicloud_db_instance" "foo" {
+		engine = "MySQL"
+		engine_version = "5.6"
+		instance_type = "rds.mysql.t1.small"
+		instance_storage = "20"
+		instance_charge_type = "Postpaid"
+		instance_name = "${var.name}"
+		vswitch_id = "${alicloud_vswitch.default.id}"
+	}
+
+	resource "alicloud_db_readonly_instance" "foo" {
+		master_db_instance_id = "${alicloud_db_instance.foo.id}"
+		engine_version = "${alicloud_db_instance.foo.engine_version}"
+		instance_type = "%s"
+		instance_storage = "%d"
+		instance_name = "${var.name}_ro"
+		vswitch_id = "${alicloud_vswitch.default.id}"
+	}
+	`, common, instanceType, storage)
+}
@@ -21,6 +21,8 @@ func resourceAliyunDisk() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsAll,
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -69,7 +71,8 @@ func resourceAliyunDisk() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -176,9 +179,7 @@ func resourceAliyunDiskRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil && !NotFoundError(err) {
 		return fmt.Errorf("[ERROR] DescribeTags for disk got error: %#v", err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
-	}
+	setTagsAll(client, d, tagsToMap(tags))
 
 	return nil
 }
@@ -188,10 +189,11 @@ func resourceAliyunDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	d.Partial(true)
 
-	if err := setTags(client, TagResourceDisk, d); err != nil {
+	if err := setTags(client, &EcsService{client}, TagResourceDisk, d); err != nil {
 		return fmt.Errorf("Set tags for instance got error: %#v", err)
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("size") && !d.IsNewResource() {
@@ -17,6 +17,9 @@ func resourceAlicloudDnsGroup() *schema.Resource {
 		Read:   resourceAlicloudDnsGroupRead,
 		Update: resourceAlicloudDnsGroupUpdate,
 		Delete: resourceAlicloudDnsGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -71,26 +74,38 @@ func resourceAlicloudDnsGroupUpdate(d *schema.ResourceData, meta interface{}) er
 func resourceAlicloudDnsGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 
+	// The name is empty when the group is being imported, and all of the groups are listed page by page.
 	args := &dns.DescribeDomainGroupsArgs{
 		KeyWord: d.Get("name").(string),
 	}
 
-	raw, err := client.WithDnsClient(func(dnsClient *dns.Client) (interface{}, error) {
-		return dnsClient.DescribeDomainGroups(args)
-	})
-	if err != nil {
-		return err
+	var allGroups []dns.DomainGroupType
+	pagination := getPagination(1, 50)
+	for {
+		args.Pagination = pagination
+		raw, err := client.WithDnsClient(func(dnsClient *dns.Client) (interface{}, error) {
+			return dnsClient.DescribeDomainGroups(args)
+		})
+		if err != nil {
+			return err
+		}
+		groups, _ := raw.([]dns.DomainGroupType)
+		for _, v := range groups {
+			if v.GroupId == d.Id() {
+				d.Set("name", v.GroupName)
+				return nil
+			}
+		}
+		allGroups = append(allGroups, groups...)
+
+		if len(groups) < pagination.PageSize {
+			break
+		}
+		pagination.PageNumber += 1
 	}
-	groups, _ := raw.([]dns.DomainGroupType)
-	if groups == nil || len(groups) <= 0 {
+	if len(allGroups) <= 0 {
 		return fmt.Errorf("No domain groups found.")
 	}
-	for _, v := range groups {
-		if v.GroupName == d.Get("name").(string) {
-			d.Set("name", v.GroupName)
-			return nil
-		}
-	}
 
 	d.SetId("")
 	return nil
@@ -22,6 +22,8 @@ func resourceAliyunEip() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsAll,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -75,6 +77,10 @@ func resourceAliyunEip() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -149,6 +155,10 @@ func resourceAliyunEipRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("ip_address", eip.IpAddress)
 	d.Set("status", eip.Status)
 
+	if err := readTags(client, &vpcService, TagResourceEip, d); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -156,6 +166,12 @@ func resourceAliyunEipUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	d.Partial(true)
 
+	if err := setTags(client, &VpcService{client}, TagResourceEip, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
 	update := false
 	request := vpc.CreateModifyEipAddressAttributeRequest()
 	request.AllocationId = d.Id()
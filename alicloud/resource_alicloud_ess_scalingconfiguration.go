@@ -20,6 +20,10 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 		Read:   resourceAliyunEssScalingConfigurationRead,
 		Update: resourceAliyunEssScalingConfigurationUpdate,
 		Delete: resourceAliyunEssScalingConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"active": {
@@ -175,6 +179,14 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 				ForceNew: true,
 			},
 
+			// The tags of a scaling configuration can not be modified, so changing the default tags
+			// of the provider creates a new one as well.
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"instance_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -379,7 +391,7 @@ func resourceAliyunEssScalingConfigurationRead(d *schema.ResourceData, meta inte
 	d.Set("key_name", c.KeyPairName)
 	d.Set("user_data", userDataHashSum(c.UserData))
 	d.Set("force_delete", d.Get("force_delete").(bool))
-	d.Set("tags", essTagsToMap(c.Tags.Tag))
+	setTagsAll(client, d, essTagsToMap(c.Tags.Tag))
 	d.Set("instance_name", c.InstanceName)
 
 	return nil
@@ -526,9 +538,9 @@ func buildAlicloudEssScalingConfigurationArgs(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v := mergeDefaultTags(meta.(*connectivity.AliyunClient), d.Get("tags").(map[string]interface{})); len(v) > 0 {
 		tags := "{"
-		for key, value := range v.(map[string]interface{}) {
+		for key, value := range v {
 			tags += "\"" + key + "\"" + ":" + "\"" + value.(string) + "\"" + ","
 		}
 		args.Tags = strings.TrimSuffix(tags, ",") + "}"
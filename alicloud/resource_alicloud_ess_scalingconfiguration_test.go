@@ -7,12 +7,34 @@ import (
 	"testing"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
+func TestResourceAlicloudEssScalingConfigurationTagsAll(t *testing.T) {
+	client := &connectivity.AliyunClient{DefaultTags: map[string]string{"Env": "test"}}
+	state := &terraform.InstanceState{ID: "asc-test", Attributes: map[string]string{
+		"tags.%": "1", "tags.Name": "web", "tags_all.%": "2", "tags_all.Name": "web", "tags_all.Env": "test",
+	}}
+	raw, err := config.NewRawConfig(map[string]interface{}{"tags": map[string]interface{}{"Name": "web"}})
+	if err != nil {
+		t.Fatalf("creating the config got an error: %#v", err)
+	}
+
+	// The tags can not be modified, so changing the default tags of the provider creates a new scaling configuration.
+	client.DefaultTags = map[string]string{"Env": "prod"}
+	diff, err := resourceAlicloudEssScalingConfiguration().Diff(state, terraform.NewResourceConfig(raw), client)
+	if err != nil {
+		t.Fatalf("diffing the resource got an error: %#v", err)
+	}
+	if attr, ok := diff.Attributes["tags_all.Env"]; !ok || attr.New != "prod" || !attr.RequiresNew {
+		t.Fatalf("expected tags_all.Env to be changed to prod with a new resource, got %#v", diff.Attributes)
+	}
+}
+
 func TestAccAlicloudEssScalingConfiguration_basic(t *testing.T) {
 	var sc ess.ScalingConfiguration
 
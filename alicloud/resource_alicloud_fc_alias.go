@@ -0,0 +1,177 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudFCAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFCAliasCreate,
+		Read:   resourceAlicloudFCAliasRead,
+		Update: resourceAlicloudFCAliasUpdate,
+		Delete: resourceAlicloudFCAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 128),
+			},
+			"service_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"routing_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_version_weights": {
+							Type:     schema.TypeMap,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeFloat},
+						},
+					},
+				},
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFCAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
+
+	serviceName := d.Get("service").(string)
+	name := d.Get("name").(string)
+	object := &fcAliasObject{
+		AliasName:               StringPointer(name),
+		VersionID:               StringPointer(d.Get("service_version").(string)),
+		Description:             StringPointer(d.Get("description").(string)),
+		AdditionalVersionWeight: expandFCAliasAdditionalVersionWeights(d),
+	}
+
+	if err := fcService.CreateFcAlias(serviceName, object); err != nil {
+		return fmt.Errorf("Error creating function compute alias got an error: %#v", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", serviceName, COLON_SEPARATED, name))
+
+	return resourceAlicloudFCAliasRead(d, meta)
+}
+
+func resourceAlicloudFCAliasRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
+
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(split) < 2 {
+		return fmt.Errorf("Invalid resource ID %s. Please check it and try again.", d.Id())
+	}
+
+	alias, err := fcService.DescribeFcAlias(split[0], split[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("DescribeFcAlias %s got an error: %#v", d.Id(), err)
+	}
+
+	d.Set("service", split[0])
+	d.Set("name", alias.AliasName)
+	d.Set("service_version", alias.VersionID)
+	d.Set("description", alias.Description)
+	d.Set("last_modified", alias.LastModifiedTime)
+
+	var routingConfig []map[string]interface{}
+	if alias.AdditionalVersionWeight != nil && len(*alias.AdditionalVersionWeight) > 0 {
+		weights := make(map[string]interface{}, len(*alias.AdditionalVersionWeight))
+		for version, weight := range *alias.AdditionalVersionWeight {
+			weights[version] = weight
+		}
+		routingConfig = append(routingConfig, map[string]interface{}{
+			"additional_version_weights": weights,
+		})
+	}
+	if err := d.Set("routing_config", routingConfig); err != nil {
+		return fmt.Errorf("[ERROR] Setting routing_config got an error: %#v", err)
+	}
+
+	return nil
+}
+
+func resourceAlicloudFCAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
+
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+	updateInput := &fcAliasObject{}
+	update := false
+
+	if d.HasChange("service_version") {
+		updateInput.VersionID = StringPointer(d.Get("service_version").(string))
+		update = true
+	}
+	if d.HasChange("description") {
+		updateInput.Description = StringPointer(d.Get("description").(string))
+		update = true
+	}
+	if d.HasChange("routing_config") {
+		// an empty map is sent to remove the routing, since a nil one means no change
+		updateInput.AdditionalVersionWeight = expandFCAliasAdditionalVersionWeights(d)
+		update = true
+	}
+
+	if update {
+		if err := fcService.UpdateFcAlias(split[0], split[1], updateInput); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlicloudFCAliasRead(d, meta)
+}
+
+func resourceAlicloudFCAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+
+	if err := fcService.DeleteFcAlias(split[0], split[1]); err != nil && !IsExceptedErrors(err, []string{ServiceNotFound, AliasNotFound}) {
+		return fmt.Errorf("Deleting alias got an error: %#v.", err)
+	}
+	return nil
+}
+
+func expandFCAliasAdditionalVersionWeights(d *schema.ResourceData) *map[string]float64 {
+	weights := make(map[string]float64)
+	if v, ok := d.GetOk("routing_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config := v.([]interface{})[0].(map[string]interface{})
+		for version, weight := range config["additional_version_weights"].(map[string]interface{}) {
+			weights[version] = weight.(float64)
+		}
+	}
+	return &weights
+}
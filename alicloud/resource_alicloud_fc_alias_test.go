@@ -0,0 +1,165 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudFCAlias_basic(t *testing.T) {
+	var alias fcAlias
+	randInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckWithRegions(t, false, connectivity.FcNoSupportedRegions) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlicloudFCAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudFCAliasBasic(randInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudFCAliasExists("alicloud_fc_alias.foo", &alias),
+					resource.TestCheckResourceAttr("alicloud_fc_alias.foo", "name", "prod"),
+					resource.TestCheckResourceAttrPair("alicloud_fc_alias.foo", "service_version",
+						"alicloud_fc_service_version.v1", "version_id"),
+					resource.TestCheckResourceAttr("alicloud_fc_alias.foo", "routing_config.#", "0"),
+				),
+			},
+			{
+				Config: testAlicloudFCAliasCanary(randInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudFCAliasExists("alicloud_fc_alias.foo", &alias),
+					resource.TestCheckResourceAttr("alicloud_fc_alias.foo", "description", "canary"),
+					resource.TestCheckResourceAttr("alicloud_fc_alias.foo", "routing_config.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_fc_alias.foo", "routing_config.0.additional_version_weights.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestFcAliasObjectJSON(t *testing.T) {
+	cases := []struct {
+		object   fcAliasObject
+		expected string
+	}{
+		{fcAliasObject{VersionID: StringPointer("2")}, `{"versionId":"2"}`},
+		{fcAliasObject{AdditionalVersionWeight: &map[string]float64{}}, `{"additionalVersionWeight":{}}`},
+		{fcAliasObject{AdditionalVersionWeight: &map[string]float64{"2": 0.1}}, `{"additionalVersionWeight":{"2":0.1}}`},
+	}
+	for _, c := range cases {
+		b, err := json.Marshal(c.object)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != c.expected {
+			t.Fatalf("Expected alias object %s, got %s", c.expected, b)
+		}
+	}
+
+	if path := fcPath(fcSingleAliasPath, "my service", "prod"); path != "/services/my%20service/aliases/prod" {
+		t.Fatalf("Unexpected alias path %s", path)
+	}
+}
+
+func testAccCheckAlicloudFCAliasExists(name string, alias *fcAlias) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FC alias ID is set")
+		}
+
+		client := testAccProvider.Meta().(*connectivity.AliyunClient)
+		fcService := FcService{client}
+		split := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		a, err := fcService.DescribeFcAlias(split[0], split[1])
+		if err != nil {
+			return err
+		}
+
+		*alias = *a
+		return nil
+	}
+}
+
+func testAccCheckAlicloudFCAliasDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AliyunClient)
+	fcService := FcService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fc_alias" {
+			continue
+		}
+
+		split := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		if _, err := fcService.DescribeFcAlias(split[0], split[1]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("Check fc alias got an error: %#v.", err)
+		}
+
+		return fmt.Errorf("FC alias %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAlicloudFCAliasBasic(randInt int) string {
+	return fmt.Sprintf(`
+variable "name" {
+    default = "tf-testacc-alicloud-fc-alias-%v"
+}
+%s
+resource "alicloud_fc_service_version" "v1" {
+  service = "${alicloud_fc_service.foo.name}"
+  depends_on = ["alicloud_fc_function.foo"]
+}
+
+resource "alicloud_fc_alias" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  name = "prod"
+  service_version = "${alicloud_fc_service_version.v1.version_id}"
+}
+`, randInt, testAlicloudFCVersionedFunctionTemplate)
+}
+
+func testAlicloudFCAliasCanary(randInt int) string {
+	return fmt.Sprintf(`
+variable "name" {
+    default = "tf-testacc-alicloud-fc-alias-%v"
+}
+%s
+resource "alicloud_fc_service_version" "v1" {
+  service = "${alicloud_fc_service.foo.name}"
+  depends_on = ["alicloud_fc_function.foo"]
+}
+
+resource "alicloud_fc_service_version" "v2" {
+  service = "${alicloud_fc_service.foo.name}"
+  description = "canary"
+  depends_on = ["alicloud_fc_service_version.v1"]
+}
+
+resource "alicloud_fc_alias" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  name = "prod"
+  description = "canary"
+  service_version = "${alicloud_fc_service_version.v1.version_id}"
+  routing_config {
+    additional_version_weights = {
+      "${alicloud_fc_service_version.v2.version_id}" = 0.1
+    }
+  }
+}
+`, randInt, testAlicloudFCVersionedFunctionTemplate)
+}
@@ -69,6 +69,12 @@ func resourceAlicloudFCFunction() *schema.Resource {
 				ConflictsWith: []string{"oss_bucket", "oss_key"},
 			},
 
+			"code_checksum": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -93,6 +99,26 @@ func resourceAlicloudFCFunction() *schema.Resource {
 				Optional: true,
 				Default:  3,
 			},
+			"environment_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"initializer": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"initialization_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(1, 300),
+			},
+			"instance_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(1, 100),
+			},
 			"last_modified": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -103,6 +129,7 @@ func resourceAlicloudFCFunction() *schema.Resource {
 
 func resourceAlicloudFCFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
 
 	serviceName := d.Get("service").(string)
 	var name string
@@ -114,36 +141,42 @@ func resourceAlicloudFCFunctionCreate(d *schema.ResourceData, meta interface{})
 		name = resource.UniqueId()
 	}
 
-	input := &fc.CreateFunctionInput{
-		ServiceName: StringPointer(serviceName),
+	object := fcFunctionCreateObject{
+		FunctionCreateObject: fc.FunctionCreateObject{
+			FunctionName: StringPointer(name),
+			Description:  StringPointer(d.Get("description").(string)),
+			Runtime:      StringPointer(d.Get("runtime").(string)),
+			Handler:      StringPointer(d.Get("handler").(string)),
+			Timeout:      Int32Pointer(int32(d.Get("timeout").(int))),
+			MemorySize:   Int32Pointer(int32(d.Get("memory_size").(int))),
+		},
+	}
+	object.EnvironmentVariables = expandFunctionEnvironmentVariables(d.Get("environment_variables").(map[string]interface{}))
+	if v, ok := d.GetOk("initializer"); ok {
+		object.Initializer = StringPointer(v.(string))
+	}
+	if v, ok := d.GetOk("initialization_timeout"); ok {
+		object.InitializationTimeout = Int32Pointer(int32(v.(int)))
 	}
-	object := fc.FunctionCreateObject{
-		FunctionName: StringPointer(name),
-		Description:  StringPointer(d.Get("description").(string)),
-		Runtime:      StringPointer(d.Get("runtime").(string)),
-		Handler:      StringPointer(d.Get("handler").(string)),
-		Timeout:      Int32Pointer(int32(d.Get("timeout").(int))),
-		MemorySize:   Int32Pointer(int32(d.Get("memory_size").(int))),
+	if v, ok := d.GetOk("instance_concurrency"); ok {
+		object.InstanceConcurrency = Int32Pointer(int32(v.(int)))
 	}
 	code, err := getFunctionCode(d, client)
 	if err != nil {
 		return err
 	}
 	object.Code = code
-	input.FunctionCreateObject = object
 
-	var function *fc.CreateFunctionOutput
+	var function *fcFunction
 	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
-			return fcClient.CreateFunction(input)
-		})
+		raw, err := fcService.CreateFcFunction(serviceName, object)
 		if err != nil {
 			if IsExceptedErrors(err, []string{AccessDenied}) {
 				return resource.RetryableError(fmt.Errorf("Error creating function compute service got an error: %#v", err))
 			}
 			return resource.NonRetryableError(fmt.Errorf("Error creating function compute service got an error: %#v", err))
 		}
-		function, _ = raw.(*fc.CreateFunctionOutput)
+		function = raw
 		return nil
 
 	}); err != nil {
@@ -185,22 +218,29 @@ func resourceAlicloudFCFunctionRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("runtime", function.Runtime)
 	d.Set("timeout", function.Timeout)
 	d.Set("last_modified", function.LastModifiedTime)
+	d.Set("code_checksum", function.CodeChecksum)
+	d.Set("environment_variables", function.EnvironmentVariables)
+	d.Set("initializer", function.Initializer)
+	d.Set("initialization_timeout", function.InitializationTimeout)
+	d.Set("instance_concurrency", function.InstanceConcurrency)
 
 	return nil
 }
 
 func resourceAlicloudFCFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
 
 	d.Partial(true)
-	updateInput := &fc.UpdateFunctionInput{}
+	updateInput := &fcFunctionUpdateObject{}
 	update := false
 
-	if d.HasChange("filename") || d.HasChange("oss_bucket") || d.HasChange("oss_key") {
+	if d.HasChange("filename") || d.HasChange("oss_bucket") || d.HasChange("oss_key") || d.HasChange("code_checksum") {
 		update = true
 		d.SetPartial("filename")
 		d.SetPartial("oss_bucket")
 		d.SetPartial("oss_key")
+		d.SetPartial("code_checksum")
 	}
 	if d.HasChange("description") {
 		updateInput.Description = StringPointer(d.Get("description").(string))
@@ -222,22 +262,34 @@ func resourceAlicloudFCFunctionUpdate(d *schema.ResourceData, meta interface{})
 		updateInput.Runtime = StringPointer(d.Get("runtime").(string))
 		d.SetPartial("runtime")
 	}
+	if d.HasChange("environment_variables") {
+		// an empty map is sent to remove all of the variables, since a nil map means no change
+		updateInput.EnvironmentVariables = expandFunctionEnvironmentVariables(d.Get("environment_variables").(map[string]interface{}))
+		d.SetPartial("environment_variables")
+	}
+	if d.HasChange("initializer") {
+		updateInput.Initializer = StringPointer(d.Get("initializer").(string))
+		d.SetPartial("initializer")
+	}
+	if d.HasChange("initialization_timeout") {
+		updateInput.InitializationTimeout = Int32Pointer(int32(d.Get("initialization_timeout").(int)))
+		d.SetPartial("initialization_timeout")
+	}
+	if d.HasChange("instance_concurrency") {
+		updateInput.InstanceConcurrency = Int32Pointer(int32(d.Get("instance_concurrency").(int)))
+		d.SetPartial("instance_concurrency")
+	}
 
 	if updateInput != nil || update {
 		split := strings.Split(d.Id(), COLON_SEPARATED)
-		updateInput.ServiceName = StringPointer(split[0])
-		updateInput.FunctionName = StringPointer(split[1])
 		code, err := getFunctionCode(d, client)
 		if err != nil {
 			return err
 		}
 		updateInput.Code = code
 
-		_, err = client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
-			return fcClient.UpdateFunction(updateInput)
-		})
-		if err != nil {
-			return fmt.Errorf("UpdateFunction %s got an error: %#v.", d.Id(), err)
+		if err := fcService.UpdateFcFunction(split[0], split[1], updateInput); err != nil {
+			return err
 		}
 	}
 
@@ -293,3 +345,11 @@ func getFunctionCode(d *schema.ResourceData, client *connectivity.AliyunClient)
 	}
 	return code, nil
 }
+
+func expandFunctionEnvironmentVariables(variables map[string]interface{}) map[string]string {
+	env := make(map[string]string, len(variables))
+	for k, v := range variables {
+		env[k] = v.(string)
+	}
+	return env
+}
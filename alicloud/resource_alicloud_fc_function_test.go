@@ -1,7 +1,12 @@
 package alicloud
 
 import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"strings"
@@ -16,7 +21,7 @@ import (
 
 func TestAccAlicloudFCFunction_basic(t *testing.T) {
 	var service fc.GetServiceOutput
-	var function fc.GetFunctionOutput
+	var function fcFunction
 	var bucket oss.BucketInfo
 
 	randInt := acctest.RandInt()
@@ -51,7 +56,106 @@ func TestAccAlicloudFCFunction_basic(t *testing.T) {
 	})
 }
 
-func testAccCheckAlicloudFCFunctionExists(name string, service *fc.GetFunctionOutput) resource.TestCheckFunc {
+func TestAccAlicloudFCFunction_initializer(t *testing.T) {
+	var function fcFunction
+	tmpFile, err := ioutil.TempFile("", "tf-testacc-fc-function")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	randInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckWithRegions(t, false, connectivity.FcNoSupportedRegions) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlicloudFCFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					writeFCFunctionZipFile(t, tmpFile.Name(), "hello world")
+				},
+				Config: testAlicloudFCFunctionInitializer(testFCRoleTemplate, randInt, tmpFile.Name(), "hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudFCFunctionExists("alicloud_fc_function.foo", &function),
+					resource.TestCheckResourceAttr("alicloud_fc_function.foo", "initializer", "hello.initializer"),
+					resource.TestCheckResourceAttr("alicloud_fc_function.foo", "initialization_timeout", "10"),
+					resource.TestCheckResourceAttr("alicloud_fc_function.foo", "environment_variables.%", "1"),
+					resource.TestCheckResourceAttr("alicloud_fc_function.foo", "environment_variables.prefix", "hello"),
+					resource.TestCheckResourceAttrPair("alicloud_fc_function.foo", "code_checksum",
+						"data.alicloud_file_crc64_checksum.foo", "checksum"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFCFunctionZipFile(t, tmpFile.Name(), "hello terraform")
+				},
+				Config: testAlicloudFCFunctionInitializer(testFCRoleTemplate, randInt, tmpFile.Name(), "bye"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudFCFunctionExists("alicloud_fc_function.foo", &function),
+					resource.TestCheckResourceAttr("alicloud_fc_function.foo", "environment_variables.prefix", "bye"),
+					resource.TestCheckResourceAttrPair("alicloud_fc_function.foo", "code_checksum",
+						"data.alicloud_file_crc64_checksum.foo", "checksum"),
+				),
+			},
+		},
+	})
+}
+
+func writeFCFunctionZipFile(t *testing.T, filename, message string) {
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	f, err := w.Create("hello.py")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := fmt.Sprintf(`# -*- coding: utf-8 -*-
+def initializer(context):
+    pass
+
+def handler(event, context):
+    return '%s'
+`, message)
+	if _, err := f.Write([]byte(code)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFcFunctionObjectJSON(t *testing.T) {
+	object := fcFunctionCreateObject{
+		FunctionCreateObject: fc.FunctionCreateObject{FunctionName: StringPointer("hello")},
+	}
+	object.InstanceConcurrency = Int32Pointer(10)
+	b, err := json.Marshal(object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(b, &body); err != nil {
+		t.Fatal(err)
+	}
+	if body["functionName"] != "hello" || body["instanceConcurrency"] != float64(10) {
+		t.Fatalf("Unexpected function object %s", b)
+	}
+	if _, ok := body["initializer"]; ok {
+		t.Fatalf("Expected the unset initializer is omitted, got %s", b)
+	}
+
+	var function fcFunction
+	if err := json.Unmarshal([]byte(`{"functionName":"hello","initializer":"index.init","initializationTimeout":3}`), &function); err != nil {
+		t.Fatal(err)
+	}
+	if *function.FunctionName != "hello" || *function.Initializer != "index.init" || *function.InitializationTimeout != 3 {
+		t.Fatalf("Unexpected function %#v", function)
+	}
+}
+
+func testAccCheckAlicloudFCFunctionExists(name string, service *fcFunction) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -234,3 +338,50 @@ resource "alicloud_ram_role_policy_attachment" "foo" {
 }
 `, randInt, role)
 }
+
+func testAlicloudFCFunctionInitializer(role string, randInt int, filename, prefix string) string {
+	return fmt.Sprintf(`
+variable "name" {
+    default = "tf-testacc-alicloud-fc-function-init-%v"
+}
+
+resource "alicloud_fc_service" "foo" {
+    name = "${var.name}"
+    description = "tf unit test"
+    role = "${alicloud_ram_role.foo.arn}"
+    depends_on = ["alicloud_ram_role_policy_attachment.foo"]
+}
+
+data "alicloud_file_crc64_checksum" "foo" {
+  filename = "%s"
+}
+
+resource "alicloud_fc_function" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  name = "${var.name}"
+  filename = "%s"
+  code_checksum = "${data.alicloud_file_crc64_checksum.foo.checksum}"
+  runtime = "python2.7"
+  handler = "hello.handler"
+  initializer = "hello.initializer"
+  initialization_timeout = 10
+  environment_variables = {
+    prefix = "%s"
+  }
+}
+
+resource "alicloud_ram_role" "foo" {
+  name = "${var.name}"
+  document = <<EOF
+  %s
+  EOF
+  description = "this is a test"
+  force = true
+}
+resource "alicloud_ram_role_policy_attachment" "foo" {
+  role_name = "${alicloud_ram_role.foo.name}"
+  policy_name = "AliyunLogFullAccess"
+  policy_type = "System"
+}
+`, randInt, filename, filename, prefix, role)
+}
@@ -0,0 +1,107 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudFCProvisionConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFCProvisionConfigPut,
+		Read:   resourceAlicloudFCProvisionConfigRead,
+		Update: resourceAlicloudFCProvisionConfigPut,
+		Delete: resourceAlicloudFCProvisionConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"function": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(1, 10000),
+			},
+			"current": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFCProvisionConfigPut(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
+
+	serviceName := d.Get("service").(string)
+	qualifier := d.Get("qualifier").(string)
+	function := d.Get("function").(string)
+	if err := fcService.PutFcProvisionConfig(serviceName, qualifier, function, int64(d.Get("target").(int))); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s%s%s", serviceName, COLON_SEPARATED, qualifier, COLON_SEPARATED, function))
+
+	return resourceAlicloudFCProvisionConfigRead(d, meta)
+}
+
+func resourceAlicloudFCProvisionConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
+
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(split) < 3 {
+		return fmt.Errorf("Invalid resource ID %s. Please check it and try again.", d.Id())
+	}
+
+	config, err := fcService.DescribeFcProvisionConfig(split[0], split[1], split[2])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("DescribeFcProvisionConfig %s got an error: %#v", d.Id(), err)
+	}
+
+	d.Set("service", split[0])
+	d.Set("qualifier", split[1])
+	d.Set("function", split[2])
+	d.Set("target", config.Target)
+	d.Set("current", config.Current)
+
+	return nil
+}
+
+// resourceAlicloudFCProvisionConfigDelete releases the provisioned instances by setting the target to 0,
+// since the provision config can not be deleted.
+func resourceAlicloudFCProvisionConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+
+	if err := fcService.PutFcProvisionConfig(split[0], split[1], split[2], 0); err != nil {
+		if IsExceptedErrors(err, []string{ServiceNotFound, FunctionNotFound, AliasNotFound}) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
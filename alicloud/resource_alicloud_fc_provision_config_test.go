@@ -0,0 +1,106 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudFCProvisionConfig_basic(t *testing.T) {
+	randInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckWithRegions(t, false, connectivity.FcNoSupportedRegions) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlicloudFCProvisionConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudFCProvisionConfigBasic(randInt, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudFCProvisionConfigExists("alicloud_fc_provision_config.foo"),
+					resource.TestCheckResourceAttr("alicloud_fc_provision_config.foo", "qualifier", "prod"),
+					resource.TestCheckResourceAttr("alicloud_fc_provision_config.foo", "target", "1"),
+				),
+			},
+			{
+				Config: testAlicloudFCProvisionConfigBasic(randInt, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudFCProvisionConfigExists("alicloud_fc_provision_config.foo"),
+					resource.TestCheckResourceAttr("alicloud_fc_provision_config.foo", "target", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlicloudFCProvisionConfigExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FC provision config ID is set")
+		}
+
+		client := testAccProvider.Meta().(*connectivity.AliyunClient)
+		fcService := FcService{client}
+		split := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		_, err := fcService.DescribeFcProvisionConfig(split[0], split[1], split[2])
+		return err
+	}
+}
+
+func testAccCheckAlicloudFCProvisionConfigDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AliyunClient)
+	fcService := FcService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fc_provision_config" {
+			continue
+		}
+
+		split := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		if _, err := fcService.DescribeFcProvisionConfig(split[0], split[1], split[2]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("Check fc provision config got an error: %#v.", err)
+		}
+
+		return fmt.Errorf("FC provision config %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAlicloudFCProvisionConfigBasic(randInt, target int) string {
+	return fmt.Sprintf(`
+variable "name" {
+    default = "tf-testacc-alicloud-fc-provision-%v"
+}
+%s
+resource "alicloud_fc_service_version" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  depends_on = ["alicloud_fc_function.foo"]
+}
+
+resource "alicloud_fc_alias" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  name = "prod"
+  service_version = "${alicloud_fc_service_version.foo.version_id}"
+}
+
+resource "alicloud_fc_provision_config" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  qualifier = "${alicloud_fc_alias.foo.name}"
+  function = "${alicloud_fc_function.foo.name}"
+  target = %d
+}
+`, randInt, testAlicloudFCVersionedFunctionTemplate, target)
+}
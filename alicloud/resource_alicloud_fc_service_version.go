@@ -0,0 +1,110 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudFCServiceVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFCServiceVersionCreate,
+		Read:   resourceAlicloudFCServiceVersionRead,
+		Delete: resourceAlicloudFCServiceVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFCServiceVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
+
+	serviceName := d.Get("service").(string)
+	version, err := fcService.PublishFcServiceVersion(serviceName, d.Get("description").(string))
+	if err != nil {
+		return fmt.Errorf("Error publishing function compute service version got an error: %#v", err)
+	}
+	if version.VersionID == nil {
+		return fmt.Errorf("Publishing function compute service version got a empty response: %#v.", version)
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", serviceName, COLON_SEPARATED, *version.VersionID))
+
+	return resourceAlicloudFCServiceVersionRead(d, meta)
+}
+
+func resourceAlicloudFCServiceVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
+
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(split) < 2 {
+		return fmt.Errorf("Invalid resource ID %s. Please check it and try again.", d.Id())
+	}
+
+	version, err := fcService.DescribeFcServiceVersion(split[0], split[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("DescribeFcServiceVersion %s got an error: %#v", d.Id(), err)
+	}
+
+	d.Set("service", split[0])
+	d.Set("version_id", version.VersionID)
+	d.Set("description", version.Description)
+	d.Set("created_time", version.CreatedTime)
+
+	return nil
+}
+
+func resourceAlicloudFCServiceVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if err := fcService.DeleteFcServiceVersion(split[0], split[1]); err != nil {
+			if IsExceptedErrors(err, []string{ServiceNotFound, VersionNotFound}) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Deleting service version got an error: %#v.", err))
+		}
+
+		if _, err := fcService.DescribeFcServiceVersion(split[0], split[1]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("While deleting service version, getting version %s got an error: %#v.", d.Id(), err))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudFCServiceVersion_basic(t *testing.T) {
+	randInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckWithRegions(t, false, connectivity.FcNoSupportedRegions) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlicloudFCServiceVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudFCServiceVersionBasic(randInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudFCServiceVersionExists("alicloud_fc_service_version.foo"),
+					resource.TestCheckResourceAttr("alicloud_fc_service_version.foo", "description", "tf unit test"),
+					resource.TestCheckResourceAttrSet("alicloud_fc_service_version.foo", "version_id"),
+					resource.TestCheckResourceAttrSet("alicloud_fc_service_version.foo", "created_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlicloudFCServiceVersionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FC service version ID is set")
+		}
+
+		client := testAccProvider.Meta().(*connectivity.AliyunClient)
+		fcService := FcService{client}
+		split := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		_, err := fcService.DescribeFcServiceVersion(split[0], split[1])
+		return err
+	}
+}
+
+func testAccCheckAlicloudFCServiceVersionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AliyunClient)
+	fcService := FcService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_fc_service_version" {
+			continue
+		}
+
+		split := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		if _, err := fcService.DescribeFcServiceVersion(split[0], split[1]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("Check fc service version got an error: %#v.", err)
+		}
+
+		return fmt.Errorf("FC service version %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAlicloudFCVersionedFunctionTemplate is a service with a function, which can be published as versions.
+const testAlicloudFCVersionedFunctionTemplate = `
+resource "alicloud_fc_service" "foo" {
+    name = "${var.name}"
+    description = "tf unit test"
+}
+
+resource "alicloud_oss_bucket" "foo" {
+  bucket = "${var.name}"
+}
+
+resource "alicloud_oss_bucket_object" "foo" {
+  bucket = "${alicloud_oss_bucket.foo.id}"
+  key = "fc/hello.zip"
+  content = <<EOF
+  	# -*- coding: utf-8 -*-
+	def handler(event, context):
+	    print "hello world"
+	    return 'hello world'
+  EOF
+}
+
+resource "alicloud_fc_function" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  name = "${var.name}"
+  oss_bucket = "${alicloud_oss_bucket.foo.id}"
+  oss_key = "${alicloud_oss_bucket_object.foo.key}"
+  runtime = "python2.7"
+  handler = "hello.handler"
+}
+`
+
+func testAlicloudFCServiceVersionBasic(randInt int) string {
+	return fmt.Sprintf(`
+variable "name" {
+    default = "tf-testacc-alicloud-fc-version-%v"
+}
+%s
+resource "alicloud_fc_service_version" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  description = "tf unit test"
+  depends_on = ["alicloud_fc_function.foo"]
+}
+`, randInt, testAlicloudFCVersionedFunctionTemplate)
+}
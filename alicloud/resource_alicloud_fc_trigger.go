@@ -3,6 +3,7 @@ package alicloud
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"strings"
@@ -68,14 +69,216 @@ func resourceAlicloudFCTrigger() *schema.Resource {
 				ForceNew: true,
 			},
 
+			// config is the raw trigger config and it is kept for the trigger configs which are not covered by the typed blocks.
 			"config": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					// The read config is json rawMessage and it does not contains space and enter.
-					return old == removeSpaceAndEnter(new)
+					if old == removeSpaceAndEnter(new) {
+						return true
+					}
+					equal, _ := compareJsonTemplateAreEquivalent(old, new)
+					return equal
+				},
+				ValidateFunc:  validateJsonString,
+				ConflictsWith: fcTriggerConfigConflicts("config"),
+			},
+
+			"oss_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"events": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAllowedStringValue(fcOssTriggerEvents()),
+							},
+						},
+						"filter_key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"filter_key_suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+				ConflictsWith: fcTriggerConfigConflicts("oss_config"),
+			},
+
+			"timer_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cron_expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateFCTimerCronExpression,
+						},
+						"payload": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+				ConflictsWith: fcTriggerConfigConflicts("timer_config"),
+			},
+
+			"log_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_logstore": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"job_max_retry_time": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							ValidateFunc: validateIntegerInRange(0, 100),
+						},
+						"job_trigger_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validateIntegerInRange(3, 600),
+						},
+						"log_project": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"log_logstore": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"function_parameter": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+				ConflictsWith: fcTriggerConfigConflicts("log_config"),
+			},
+
+			"http_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      fc.AuthAnonymous,
+							ValidateFunc: validateAllowedStringValue([]string{fc.AuthAnonymous, fc.AuthFunction}),
+						},
+						"methods": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAllowedStringValue([]string{"GET", "POST", "PUT", "DELETE", "HEAD", "PATCH"}),
+							},
+						},
+					},
+				},
+				ConflictsWith: fcTriggerConfigConflicts("http_config"),
+			},
+
+			"mns_topic_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter_tag": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateStringLengthInRange(0, 16),
+						},
+						"notify_content_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "STREAM",
+							ValidateFunc: validateAllowedStringValue([]string{"STREAM", "JSON"}),
+						},
+						"notify_strategy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "BACKOFF_RETRY",
+							ValidateFunc: validateAllowedStringValue([]string{"BACKOFF_RETRY", "EXPONENTIAL_DECAY_RETRY"}),
+						},
+					},
 				},
-				ValidateFunc: validateJsonString,
+				ConflictsWith: fcTriggerConfigConflicts("mns_topic_config"),
+			},
+
+			"cdn_events_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"event_version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"notes": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+				ConflictsWith: fcTriggerConfigConflicts("cdn_events_config"),
 			},
 
 			"type": {
@@ -83,7 +286,14 @@ func resourceAlicloudFCTrigger() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				ValidateFunc: validateAllowedStringValue([]string{string(fc.TRIGGER_TYPE_HTTP), string(fc.TRIGGER_TYPE_LOG),
-					string(fc.TRIGGER_TYPE_OSS), string(fc.TRIGGER_TYPE_TIMER)}),
+					string(fc.TRIGGER_TYPE_OSS), string(fc.TRIGGER_TYPE_TIMER), fcTriggerTypeMnsTopic,
+					fcTriggerTypeCdnEvents}),
+			},
+
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
 			},
 
 			"last_modified": {
@@ -96,6 +306,7 @@ func resourceAlicloudFCTrigger() *schema.Resource {
 
 func resourceAlicloudFCTriggerCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
 
 	serviceName := d.Get("service").(string)
 	fcName := d.Get("function").(string)
@@ -108,37 +319,35 @@ func resourceAlicloudFCTriggerCreate(d *schema.ResourceData, meta interface{}) e
 		name = resource.UniqueId()
 	}
 
-	var config interface{}
-	if err := json.Unmarshal([]byte(d.Get("config").(string)), &config); err != nil {
-		return fmt.Errorf("Unmarshalling config got an error: %#v.", err)
+	config, err := buildFCTriggerConfig(d)
+	if err != nil {
+		return err
 	}
 
-	object := fc.TriggerCreateObject{
-		TriggerName:    StringPointer(name),
-		TriggerType:    StringPointer(d.Get("type").(string)),
-		InvocationRole: StringPointer(d.Get("role").(string)),
-		TriggerConfig:  config,
+	object := fcTriggerCreateObject{
+		TriggerCreateObject: fc.TriggerCreateObject{
+			TriggerName:    StringPointer(name),
+			TriggerType:    StringPointer(d.Get("type").(string)),
+			InvocationRole: StringPointer(d.Get("role").(string)),
+			TriggerConfig:  config,
+		},
 	}
 	if v, ok := d.GetOk("source_arn"); ok && v.(string) != "" {
 		object.SourceARN = StringPointer(v.(string))
 	}
-	input := &fc.CreateTriggerInput{
-		ServiceName:         StringPointer(serviceName),
-		FunctionName:        StringPointer(fcName),
-		TriggerCreateObject: object,
+	if v, ok := d.GetOk("qualifier"); ok && v.(string) != "" {
+		object.Qualifier = StringPointer(v.(string))
 	}
-	var trigger *fc.CreateTriggerOutput
+	var trigger *fcTrigger
 	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
-			return fcClient.CreateTrigger(input)
-		})
+		raw, err := fcService.CreateFcTrigger(serviceName, fcName, object)
 		if err != nil {
 			if IsExceptedErrors(err, []string{AccessDenied}) {
 				return resource.RetryableError(fmt.Errorf("Error creating function compute service got an error: %#v", err))
 			}
 			return resource.NonRetryableError(fmt.Errorf("Error creating function compute trigger got an error: %#v", err))
 		}
-		trigger, _ = raw.(*fc.CreateTriggerOutput)
+		trigger = raw
 		return nil
 
 	}); err != nil {
@@ -185,7 +394,19 @@ func resourceAlicloudFCTriggerRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("[ERROR] Setting config got an error: %#v", err)
 	}
 
+	// The typed config block of the trigger type is always set, and the unknown types only have the raw config.
+	if block, ok := fcTriggerConfigBlocks[StringValue(trigger.TriggerType)]; ok {
+		config, err := unmarshalFCTriggerConfig(StringValue(trigger.TriggerType), trigger.RawTriggerConfig)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Unmarshalling %s got an error: %#v", block, err)
+		}
+		if err := d.Set(block, flattenFCTriggerConfig(config)); err != nil {
+			return fmt.Errorf("[ERROR] Setting %s got an error: %#v", block, err)
+		}
+	}
+
 	d.Set("type", trigger.TriggerType)
+	d.Set("qualifier", trigger.Qualifier)
 	d.Set("last_modified", trigger.LastModifiedTime)
 
 	return nil
@@ -193,34 +414,35 @@ func resourceAlicloudFCTriggerRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceAlicloudFCTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
+	fcService := FcService{client}
 
-	updateInput := &fc.UpdateTriggerInput{}
+	updateInput := &fcTriggerUpdateObject{}
 
 	if d.HasChange("role") {
 		updateInput.InvocationRole = StringPointer(d.Get("role").(string))
 	}
-	if d.HasChange("config") {
-		var config interface{}
-		if err := json.Unmarshal([]byte(d.Get("config").(string)), &config); err != nil {
-			return fmt.Errorf("When updating, unmarshalling config got an error: %#v.", err)
+	configChanged := d.HasChange("config")
+	for _, block := range fcTriggerConfigBlocks {
+		configChanged = configChanged || d.HasChange(block)
+	}
+	if configChanged {
+		config, err := buildFCTriggerConfig(d)
+		if err != nil {
+			return err
 		}
 		updateInput.TriggerConfig = config
 	}
+	if d.HasChange("qualifier") {
+		updateInput.Qualifier = StringPointer(d.Get("qualifier").(string))
+	}
 
 	if updateInput != nil {
 		split := strings.Split(d.Id(), COLON_SEPARATED)
 		if len(split) < 3 {
 			return fmt.Errorf("Invalid resource ID %s. Please check it and try again.", d.Id())
 		}
-		updateInput.ServiceName = StringPointer(split[0])
-		updateInput.FunctionName = StringPointer(split[1])
-		updateInput.TriggerName = StringPointer(split[2])
-
-		_, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
-			return fcClient.UpdateTrigger(updateInput)
-		})
-		if err != nil {
-			return fmt.Errorf("UpdateTrigger %s got an error: %#v.", d.Id(), err)
+		if err := fcService.UpdateFcTrigger(split[0], split[1], split[2], updateInput); err != nil {
+			return err
 		}
 	}
 
@@ -261,3 +483,249 @@ func resourceAlicloudFCTriggerDelete(d *schema.ResourceData, meta interface{}) e
 	})
 
 }
+
+// fcTriggerConfigBlocks maps the trigger types to their typed config blocks.
+var fcTriggerConfigBlocks = map[string]string{
+	fc.TRIGGER_TYPE_OSS:    "oss_config",
+	fc.TRIGGER_TYPE_TIMER:  "timer_config",
+	fc.TRIGGER_TYPE_LOG:    "log_config",
+	fc.TRIGGER_TYPE_HTTP:   "http_config",
+	fcTriggerTypeMnsTopic:  "mns_topic_config",
+	fcTriggerTypeCdnEvents: "cdn_events_config",
+}
+
+func fcTriggerConfigConflicts(key string) (conflicts []string) {
+	for _, block := range append([]string{"config"}, sortedFCTriggerConfigBlocks()...) {
+		if block != key {
+			conflicts = append(conflicts, block)
+		}
+	}
+	return
+}
+
+func sortedFCTriggerConfigBlocks() (blocks []string) {
+	for _, block := range fcTriggerConfigBlocks {
+		blocks = append(blocks, block)
+	}
+	sort.Strings(blocks)
+	return
+}
+
+func fcOssTriggerEvents() []string {
+	return []string{
+		string(fc.OSSEventObjectCreatedAll),
+		string(fc.OSSEventObjectCreatedPutObject),
+		string(fc.OSSEventObjectCreatedPutSymlink),
+		string(fc.OSSEventObjectCreatedPostObject),
+		string(fc.OSSEventObjectCreatedCopyObject),
+		string(fc.OSSEventObjectCreatedInitiateMultipartUpload),
+		string(fc.OSSEventObjectCreatedUploadPart),
+		string(fc.OSSEventObjectCreatedUploadPartCopy),
+		string(fc.OSSEventObjectCreatedCompleteMultipartUpload),
+		string(fc.OSSEventObjectCreatedAppendObject),
+		string(fc.OSSEventObjectRemovedDeleteObject),
+		string(fc.OSSEventObjectRemovedDeleteObjects),
+		string(fc.OSSEventObjectRemovedAbortMultipartUpload),
+		string(fc.OSSEventObjectReplicationObjectCreated),
+		string(fc.OSSEventObjectReplicationObjectRemoved),
+		string(fc.OSSEventObjectReplicationObjectModified),
+	}
+}
+
+// buildFCTriggerConfig builds the trigger config from the typed config block, and the raw config
+// is used when it is changed or there is no typed config block.
+func buildFCTriggerConfig(d *schema.ResourceData) (interface{}, error) {
+	// The typed config block is also read back from the raw config, so a changed raw config takes precedence.
+	if d.HasChange("config") {
+		if v, ok := d.GetOk("config"); ok && v.(string) != "" {
+			return unmarshalFCTriggerRawConfig(v.(string))
+		}
+	}
+	triggerType := d.Get("type").(string)
+	for t, block := range fcTriggerConfigBlocks {
+		v, ok := d.GetOk(block)
+		if !ok || len(v.([]interface{})) < 1 {
+			continue
+		}
+		if t != triggerType {
+			return nil, fmt.Errorf("%s can not be used by the trigger type %s, it is used by the trigger type %s.", block, triggerType, t)
+		}
+		config, ok := v.([]interface{})[0].(map[string]interface{})
+		if !ok {
+			config = make(map[string]interface{})
+		}
+
+		switch t {
+		case fc.TRIGGER_TYPE_OSS:
+			var events []string
+			if set, ok := config["events"].(*schema.Set); ok {
+				events = expandStringList(set.List())
+			}
+			ossConfig := fc.NewOSSTriggerConfig().WithEvents(events)
+			if prefix, ok := config["filter_key_prefix"].(string); ok && prefix != "" {
+				ossConfig.WithFilterKeyPrefix(prefix)
+			}
+			if suffix, ok := config["filter_key_suffix"].(string); ok && suffix != "" {
+				ossConfig.WithFilterKeySuffix(suffix)
+			}
+			return ossConfig, nil
+		case fc.TRIGGER_TYPE_TIMER:
+			timerConfig := fc.NewTimeTriggerConfig().
+				WithCronExpression(config["cron_expression"].(string)).
+				WithEnable(config["enable"].(bool))
+			if payload, ok := config["payload"].(string); ok && payload != "" {
+				timerConfig.WithPayload(payload)
+			}
+			return timerConfig, nil
+		case fc.TRIGGER_TYPE_LOG:
+			parameters := make(map[string]interface{})
+			if m, ok := config["function_parameter"].(map[string]interface{}); ok {
+				for k, v := range m {
+					parameters[k] = v
+				}
+			}
+			return fc.NewLogTriggerConfig().
+				WithSourceConfig(fc.NewSourceConfig().WithLogstore(config["source_logstore"].(string))).
+				WithJobConfig(fc.NewJobConfig().
+					WithMaxRetryTime(config["job_max_retry_time"].(int)).
+					WithTriggerInterval(config["job_trigger_interval"].(int))).
+				WithLogConfig(fc.NewJobLogConfig().
+					WithProject(config["log_project"].(string)).
+					WithLogstore(config["log_logstore"].(string))).
+				WithFunctionParameter(parameters).
+				WithEnable(config["enable"].(bool)), nil
+		case fc.TRIGGER_TYPE_HTTP:
+			var methods []string
+			if set, ok := config["methods"].(*schema.Set); ok {
+				methods = expandStringList(set.List())
+			}
+			return fc.NewHTTPTriggerConfig().
+				WithAuthType(config["auth_type"].(string)).
+				WithMethods(methods...), nil
+		case fcTriggerTypeMnsTopic:
+			mnsConfig := &fcMnsTopicTriggerConfig{
+				NotifyContentFormat: StringPointer(config["notify_content_format"].(string)),
+				NotifyStrategy:      StringPointer(config["notify_strategy"].(string)),
+			}
+			if tag, ok := config["filter_tag"].(string); ok && tag != "" {
+				mnsConfig.FilterTag = StringPointer(tag)
+			}
+			return mnsConfig, nil
+		case fcTriggerTypeCdnEvents:
+			filter := make(map[string][]string)
+			for _, f := range config["filter"].([]interface{}) {
+				item := f.(map[string]interface{})
+				filter[item["key"].(string)] = expandStringList(item["values"].([]interface{}))
+			}
+			return &fcCdnEventsTriggerConfig{
+				EventName:    StringPointer(config["event_name"].(string)),
+				EventVersion: StringPointer(config["event_version"].(string)),
+				Notes:        StringPointer(config["notes"].(string)),
+				Filter:       filter,
+			}, nil
+		}
+	}
+
+	if v, ok := d.GetOk("config"); ok && v.(string) != "" {
+		return unmarshalFCTriggerRawConfig(v.(string))
+	}
+	return nil, fmt.Errorf("One of config and %s must be set.", strings.Join(sortedFCTriggerConfigBlocks(), ", "))
+}
+
+func unmarshalFCTriggerRawConfig(raw string) (interface{}, error) {
+	var config interface{}
+	if err := json.Unmarshal([]byte(raw), &config); err != nil {
+		return nil, fmt.Errorf("Unmarshalling config got an error: %#v.", err)
+	}
+	return config, nil
+}
+
+// unmarshalFCTriggerConfig unmarshals the raw config into the config of the trigger type, or returns nil for the unknown types.
+func unmarshalFCTriggerConfig(triggerType string, raw json.RawMessage) (interface{}, error) {
+	var config interface{}
+	switch triggerType {
+	case fc.TRIGGER_TYPE_OSS:
+		config = &fc.OSSTriggerConfig{}
+	case fc.TRIGGER_TYPE_TIMER:
+		config = &fc.TimeTriggerConfig{}
+	case fc.TRIGGER_TYPE_LOG:
+		config = &fc.LogTriggerConfig{}
+	case fc.TRIGGER_TYPE_HTTP:
+		config = &fc.HTTPTriggerConfig{}
+	case fcTriggerTypeMnsTopic:
+		config = &fcMnsTopicTriggerConfig{}
+	case fcTriggerTypeCdnEvents:
+		config = &fcCdnEventsTriggerConfig{}
+	default:
+		return nil, nil
+	}
+	if err := json.Unmarshal(raw, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func flattenFCTriggerConfig(triggerConfig interface{}) []map[string]interface{} {
+	config := make(map[string]interface{})
+	switch c := triggerConfig.(type) {
+	case *fc.OSSTriggerConfig:
+		config["events"] = c.Events
+		if c.Filter != nil && c.Filter.Key != nil {
+			config["filter_key_prefix"] = StringValue(c.Filter.Key.Prefix)
+			config["filter_key_suffix"] = StringValue(c.Filter.Key.Suffix)
+		}
+	case *fc.TimeTriggerConfig:
+		config["cron_expression"] = StringValue(c.CronExpression)
+		config["payload"] = StringValue(c.Payload)
+		config["enable"] = c.Enable == nil || *c.Enable
+	case *fc.LogTriggerConfig:
+		if c.SourceConfig != nil {
+			config["source_logstore"] = StringValue(c.SourceConfig.Logstore)
+		}
+		if c.JobConfig != nil {
+			if c.JobConfig.MaxRetryTime != nil {
+				config["job_max_retry_time"] = *c.JobConfig.MaxRetryTime
+			}
+			if c.JobConfig.TriggerInterval != nil {
+				config["job_trigger_interval"] = *c.JobConfig.TriggerInterval
+			}
+		}
+		if c.LogConfig != nil {
+			config["log_project"] = StringValue(c.LogConfig.Project)
+			config["log_logstore"] = StringValue(c.LogConfig.Logstore)
+		}
+		parameters := make(map[string]interface{})
+		for k, v := range c.FunctionParameter {
+			parameters[k] = fmt.Sprint(v)
+		}
+		config["function_parameter"] = parameters
+		config["enable"] = c.Enable == nil || *c.Enable
+	case *fc.HTTPTriggerConfig:
+		config["auth_type"] = StringValue(c.AuthType)
+		config["methods"] = c.Methods
+	case *fcMnsTopicTriggerConfig:
+		config["filter_tag"] = StringValue(c.FilterTag)
+		config["notify_content_format"] = StringValue(c.NotifyContentFormat)
+		config["notify_strategy"] = StringValue(c.NotifyStrategy)
+	case *fcCdnEventsTriggerConfig:
+		config["event_name"] = StringValue(c.EventName)
+		config["event_version"] = StringValue(c.EventVersion)
+		config["notes"] = StringValue(c.Notes)
+		var keys []string
+		for k := range c.Filter {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var filter []map[string]interface{}
+		for _, k := range keys {
+			filter = append(filter, map[string]interface{}{
+				"key":    k,
+				"values": c.Filter[k],
+			})
+		}
+		config["filter"] = filter
+	default:
+		return nil
+	}
+	return []map[string]interface{}{config}
+}
@@ -1,6 +1,7 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -18,8 +19,8 @@ func TestAccAlicloudFCTrigger_log(t *testing.T) {
 	var service fc.GetServiceOutput
 	var project sls.LogProject
 	var store sls.LogStore
-	var function fc.GetFunctionOutput
-	var trigger fc.GetTriggerOutput
+	var function fcFunction
+	var trigger fcTrigger
 
 	randInt := acctest.RandInt()
 	resource.Test(t, resource.TestCase{
@@ -51,7 +52,60 @@ func TestAccAlicloudFCTrigger_log(t *testing.T) {
 	})
 }
 
-func testAccCheckAlicloudFCTriggerExists(name string, trigger *fc.GetTriggerOutput) resource.TestCheckFunc {
+func TestUnmarshalFCTriggerConfig(t *testing.T) {
+	raw := json.RawMessage(`{"eventName":"LogFileCreated","eventVersion":"1.0.0","notes":"cdn events","filter":{"domain":["a.com","b.com"]}}`)
+	config, err := unmarshalFCTriggerConfig(fcTriggerTypeCdnEvents, raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	flattened := flattenFCTriggerConfig(config)
+	if len(flattened) != 1 || flattened[0]["event_name"] != "LogFileCreated" || flattened[0]["notes"] != "cdn events" {
+		t.Fatalf("Unexpected cdn events config %#v", flattened)
+	}
+	filter := flattened[0]["filter"].([]map[string]interface{})
+	if len(filter) != 1 || filter[0]["key"] != "domain" || len(filter[0]["values"].([]string)) != 2 {
+		t.Fatalf("Unexpected cdn events filter %#v", filter)
+	}
+
+	if config, err := unmarshalFCTriggerConfig("unknown", raw); err != nil || config != nil {
+		t.Fatalf("Expected no config for the unknown trigger type, got %#v, %v", config, err)
+	}
+}
+
+func TestAccAlicloudFCTrigger_timerConfig(t *testing.T) {
+	var trigger fcTrigger
+
+	randInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckWithRegions(t, false, connectivity.FcNoSupportedRegions) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlicloudFCTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudFCTriggerTimerConfig("@every 1m", randInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudFCTriggerExists("alicloud_fc_trigger.foo", &trigger),
+					resource.TestCheckResourceAttr("alicloud_fc_trigger.foo", "type", "timer"),
+					resource.TestCheckResourceAttr("alicloud_fc_trigger.foo", "timer_config.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_fc_trigger.foo", "timer_config.0.cron_expression", "@every 1m"),
+					resource.TestCheckResourceAttr("alicloud_fc_trigger.foo", "timer_config.0.payload", "hello"),
+					resource.TestCheckResourceAttr("alicloud_fc_trigger.foo", "timer_config.0.enable", "true"),
+					resource.TestCheckResourceAttrSet("alicloud_fc_trigger.foo", "config"),
+				),
+			},
+			{
+				Config: testAlicloudFCTriggerTimerConfig("0 0 4 * * *", randInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudFCTriggerExists("alicloud_fc_trigger.foo", &trigger),
+					resource.TestCheckResourceAttr("alicloud_fc_trigger.foo", "timer_config.0.cron_expression", "0 0 4 * * *"),
+					resource.TestCheckResourceAttrSet("alicloud_fc_trigger.foo", "config"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlicloudFCTriggerExists(name string, trigger *fcTrigger) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -297,6 +351,55 @@ resource "alicloud_ram_role_policy_attachment" "foo" {
 `, randInt, trigger, role, policy)
 }
 
+func testAlicloudFCTriggerTimerConfig(cron string, randInt int) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "tf-testacc-fc-trigger-timer-%v"
+}
+
+resource "alicloud_fc_service" "foo" {
+  name = "${var.name}"
+  internet_access = false
+}
+
+resource "alicloud_oss_bucket" "foo" {
+  bucket = "${var.name}"
+}
+
+resource "alicloud_oss_bucket_object" "foo" {
+  bucket = "${alicloud_oss_bucket.foo.id}"
+  key = "fc/hello.zip"
+  content = <<EOF
+  	# -*- coding: utf-8 -*-
+	def handler(event, context):
+	    print "hello world"
+	    return 'hello world'
+  EOF
+}
+
+resource "alicloud_fc_function" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  name = "${var.name}"
+  oss_bucket = "${alicloud_oss_bucket.foo.id}"
+  oss_key = "${alicloud_oss_bucket_object.foo.key}"
+  memory_size = 512
+  runtime = "python2.7"
+  handler = "hello.handler"
+}
+
+resource "alicloud_fc_trigger" "foo" {
+  service = "${alicloud_fc_service.foo.name}"
+  function = "${alicloud_fc_function.foo.name}"
+  name = "${var.name}"
+  type = "timer"
+  timer_config {
+    cron_expression = "%s"
+    payload = "hello"
+  }
+}
+`, randInt, cron)
+}
+
 var testTriggerLogTemplate = `
     {
         "sourceConfig": {
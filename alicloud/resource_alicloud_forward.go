@@ -2,7 +2,7 @@ package alicloud
 
 import (
 	"fmt"
-
+	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
@@ -17,6 +17,9 @@ func resourceAliyunForwardEntry() *schema.Resource {
 		Read:   resourceAliyunForwardEntryRead,
 		Update: resourceAliyunForwardEntryUpdate,
 		Delete: resourceAliyunForwardEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunForwardEntryImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"forward_table_id": {
@@ -110,6 +113,18 @@ func resourceAliyunForwardEntryRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+// resourceAliyunForwardEntryImport imports a forward entry by the id <forward_table_id>:<forward_entry_id>,
+// since the forward table is required to describe the entry.
+func resourceAliyunForwardEntryImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, fmt.Errorf("Invalid forward entry import id %s, expected <forward_table_id>:<forward_entry_id>.", d.Id())
+	}
+	d.Set("forward_table_id", split[0])
+	d.SetId(split[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAliyunForwardEntryUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	vpcService := VpcService{client}
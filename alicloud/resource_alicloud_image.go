@@ -0,0 +1,382 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAliyunImage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunImageCreate,
+		Read:   resourceAliyunImageRead,
+		Update: resourceAliyunImageUpdate,
+		Delete: resourceAliyunImageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffTagsAll,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_id"},
+			},
+
+			"snapshot_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"instance_id"},
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDiskName,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDiskDescription,
+			},
+
+			"platform": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"architecture": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"i386", "x86_64"}),
+			},
+
+			"share_with_accounts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"copy_to_regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"copied_image_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
+
+func resourceAliyunImageCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	args := ecs.CreateCreateImageRequest()
+	if v, ok := d.GetOk("instance_id"); ok && v.(string) != "" {
+		args.InstanceId = v.(string)
+	}
+	if v, ok := d.GetOk("snapshot_id"); ok && v.(string) != "" {
+		args.SnapshotId = v.(string)
+	}
+	if args.InstanceId == "" && args.SnapshotId == "" {
+		return fmt.Errorf("One of instance_id or snapshot_id is required when creating an image.")
+	}
+	if v, ok := d.GetOk("name"); ok && v.(string) != "" {
+		args.ImageName = v.(string)
+	}
+	if v, ok := d.GetOk("description"); ok && v.(string) != "" {
+		args.Description = v.(string)
+	}
+	if v, ok := d.GetOk("platform"); ok && v.(string) != "" {
+		args.Platform = v.(string)
+	}
+	if v, ok := d.GetOk("architecture"); ok && v.(string) != "" {
+		args.Architecture = v.(string)
+	}
+	args.ClientToken = buildClientToken("TF-CreateImage")
+
+	var raw interface{}
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.CreateImage(args)
+		})
+		if err != nil {
+			if IsExceptedErrors(err, SnapshotInvalidOperations) {
+				return resource.RetryableError(fmt.Errorf("CreateImage timeout and got an error: %#v", err))
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("CreateImage got an error: %#v", err)
+	}
+	resp, _ := raw.(*ecs.CreateImageResponse)
+	if resp == nil {
+		return fmt.Errorf("CreateImage got a nil response: %#v", resp)
+	}
+
+	d.SetId(resp.ImageId)
+
+	if err := ecsService.WaitForImage("", d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("Waitting for image %s %s got an error: %#v.", d.Id(), Available, err)
+	}
+
+	return resourceAliyunImageUpdate(d, meta)
+}
+
+func resourceAliyunImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	image, err := ecsService.DescribeImageInRegion("", d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error DescribeImage: %#v", err)
+	}
+
+	d.Set("name", image.ImageName)
+	d.Set("description", image.Description)
+	d.Set("platform", image.Platform)
+	d.Set("architecture", image.Architecture)
+	d.Set("status", image.Status)
+
+	accounts, err := ecsService.DescribeImageShareAccounts(d.Id())
+	if err != nil {
+		return fmt.Errorf("DescribeImageSharePermission got an error: %#v", err)
+	}
+	d.Set("share_with_accounts", accounts)
+
+	// The copies of the image are only known by the state, and the ones which have been removed are forgotten.
+	copies := make(map[string]interface{})
+	var regions []string
+	for region, id := range d.Get("copied_image_ids").(map[string]interface{}) {
+		if _, err := ecsService.DescribeImageInRegion(region, id.(string)); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("Describing the copy %s of image %s in region %s got an error: %#v", id, d.Id(), region, err)
+		}
+		copies[region] = id
+		regions = append(regions, region)
+	}
+	d.Set("copied_image_ids", copies)
+	d.Set("copy_to_regions", regions)
+
+	return readTags(client, &ecsService, TagResourceImage, d)
+}
+
+func resourceAliyunImageUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	d.Partial(true)
+
+	if err := setTags(client, &ecsService, TagResourceImage, d); err != nil {
+		return fmt.Errorf("Set tags for image got error: %#v", err)
+	} else {
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	if !d.IsNewResource() && (d.HasChange("name") || d.HasChange("description")) {
+		args := ecs.CreateModifyImageAttributeRequest()
+		args.ImageId = d.Id()
+		args.ImageName = d.Get("name").(string)
+		args.Description = d.Get("description").(string)
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ModifyImageAttribute(args)
+		})
+		if err != nil {
+			return fmt.Errorf("ModifyImageAttribute got an error: %#v", err)
+		}
+		d.SetPartial("name")
+		d.SetPartial("description")
+	}
+
+	if d.HasChange("share_with_accounts") {
+		o, n := d.GetChange("share_with_accounts")
+		add := expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+		remove := expandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+
+		args := ecs.CreateModifyImageSharePermissionRequest()
+		args.ImageId = d.Id()
+		if len(add) > 0 {
+			args.AddAccount = &add
+		}
+		if len(remove) > 0 {
+			args.RemoveAccount = &remove
+		}
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ModifyImageSharePermission(args)
+		})
+		if err != nil {
+			return fmt.Errorf("ModifyImageSharePermission got an error: %#v", err)
+		}
+		d.SetPartial("share_with_accounts")
+	}
+
+	if d.HasChange("copy_to_regions") {
+		if err := copyImageToRegions(d, meta); err != nil {
+			return err
+		}
+		d.SetPartial("copy_to_regions")
+	}
+
+	d.Partial(false)
+
+	return resourceAliyunImageRead(d, meta)
+}
+
+// copyImageToRegions copies the image to the new regions of copy_to_regions, and deletes its copies in the removed ones.
+func copyImageToRegions(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	copies := d.Get("copied_image_ids").(map[string]interface{})
+	o, n := d.GetChange("copy_to_regions")
+
+	for _, region := range expandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List()) {
+		id, ok := copies[region]
+		if !ok {
+			continue
+		}
+		if err := deleteImage(client, region, id.(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+		delete(copies, region)
+		d.Set("copied_image_ids", copies)
+		d.SetPartial("copied_image_ids")
+	}
+
+	for _, region := range expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List()) {
+		if _, ok := copies[region]; ok {
+			continue
+		}
+		args := ecs.CreateCopyImageRequest()
+		args.ImageId = d.Id()
+		args.DestinationRegionId = region
+		args.DestinationImageName = d.Get("name").(string)
+		args.DestinationDescription = d.Get("description").(string)
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.CopyImage(args)
+		})
+		if err != nil {
+			return fmt.Errorf("Copying image %s to region %s got an error: %#v", d.Id(), region, err)
+		}
+		resp, _ := raw.(*ecs.CopyImageResponse)
+		if resp == nil {
+			return fmt.Errorf("CopyImage got a nil response: %#v", resp)
+		}
+		copies[region] = resp.ImageId
+		d.Set("copied_image_ids", copies)
+		d.SetPartial("copied_image_ids")
+
+		if err := ecsService.WaitForImage(region, resp.ImageId, Available, int(timeout.Seconds())); err != nil {
+			return fmt.Errorf("Waitting for the copy %s of image %s in region %s got an error: %#v.", resp.ImageId, d.Id(), region, err)
+		}
+	}
+	return nil
+}
+
+func resourceAliyunImageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	for region, id := range d.Get("copied_image_ids").(map[string]interface{}) {
+		if err := deleteImage(client, region, id.(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("share_with_accounts"); ok && v.(*schema.Set).Len() > 0 {
+		accounts := expandStringList(v.(*schema.Set).List())
+		args := ecs.CreateModifyImageSharePermissionRequest()
+		args.ImageId = d.Id()
+		args.RemoveAccount = &accounts
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ModifyImageSharePermission(args)
+		})
+		if err != nil && !NotFoundError(err) && !IsExceptedErrors(err, ImageNotFound) {
+			return fmt.Errorf("Unsharing image %s got an error: %#v", d.Id(), err)
+		}
+	}
+
+	return deleteImage(client, "", d.Id(), d.Timeout(schema.TimeoutDelete))
+}
+
+// deleteImage deletes an image in the given region, which is the region of the client when it is empty.
+func deleteImage(client *connectivity.AliyunClient, regionId, imageId string, timeout time.Duration) error {
+	ecsService := EcsService{client}
+
+	req := ecs.CreateDeleteImageRequest()
+	setEcsRequestRegion(req.RpcRequest, regionId)
+	req.ImageId = imageId
+	req.Force = requests.NewBoolean(true)
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DeleteImage(req)
+		})
+		if err != nil {
+			if NotFoundError(err) || IsExceptedErrors(err, ImageNotFound) {
+				return nil
+			}
+			if IsExceptedErrors(err, ImageInvalidOperations) {
+				return resource.RetryableError(fmt.Errorf("Deleting Image %s timeout and got an error: %#v.", imageId, err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Deleting image %s got an error: %#v.", imageId, err))
+		}
+
+		if _, err := ecsService.DescribeImageInRegion(regionId, imageId); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("While deleting image %s, describing image got an error: %#v.", imageId, err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Deleting Image %s timeout.", imageId))
+	})
+}
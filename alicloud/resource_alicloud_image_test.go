@@ -0,0 +1,181 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudImage_basic(t *testing.T) {
+	var v ecs.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_image.image",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageConfig(EcsInstanceCommonTestCase, "tf-testAccImageConfig"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists("alicloud_image.image", &v),
+					resource.TestCheckResourceAttr("alicloud_image.image", "name", "tf-testAccImageConfig"),
+					resource.TestCheckResourceAttr("alicloud_image.image", "status", "Available"),
+					resource.TestCheckResourceAttr("alicloud_image.image", "tags.%", "1"),
+					resource.TestCheckResourceAttrSet("alicloud_image.image", "architecture"),
+				),
+			},
+			{
+				Config: testAccImageConfig(EcsInstanceCommonTestCase, "tf-testAccImageConfigUpdate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists("alicloud_image.image", &v),
+					resource.TestCheckResourceAttr("alicloud_image.image", "name", "tf-testAccImageConfigUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudImage_fromSnapshot(t *testing.T) {
+	var v ecs.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_image.image",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageConfigFromSnapshot(EcsInstanceCommonTestCase),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists("alicloud_image.image", &v),
+					resource.TestCheckResourceAttr("alicloud_image.image", "architecture", "x86_64"),
+					resource.TestCheckResourceAttr("alicloud_image.image", "share_with_accounts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImageExists(n string, image *ecs.Image) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Image ID is set")
+		}
+
+		client := testAccProvider.Meta().(*connectivity.AliyunClient)
+		ecsService := EcsService{client}
+
+		v, err := ecsService.DescribeImageInRegion("", rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("While checking image existing, describing image got an error: %#v.", err)
+		}
+
+		*image = v
+		return nil
+	}
+}
+
+func testAccCheckImageDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_image" {
+			continue
+		}
+
+		if _, err := ecsService.DescribeImageInRegion("", rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("While checking image destroy, describing image got an error: %#v.", err)
+		}
+		return fmt.Errorf("ECS Image %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccImageConfig(common, name string) string {
+	return fmt.Sprintf(`
+	%s
+	variable "name" {
+		default = "tf-testAccImageConfig"
+	}
+
+	resource "alicloud_instance" "instance" {
+		image_id = "${data.alicloud_images.default.images.0.id}"
+		availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+		system_disk_category = "cloud_efficiency"
+		instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+		security_groups = ["${alicloud_security_group.default.id}"]
+		instance_name = "${var.name}"
+		vswitch_id = "${alicloud_vswitch.default.id}"
+	}
+
+	resource "alicloud_image" "image" {
+	  instance_id = "${alicloud_instance.instance.id}"
+	  name = "%s"
+	  description = "Hello ecs image."
+	  tags {
+	    Name = "TerraformTest"
+	  }
+	}
+	`, common, name)
+}
+
+func testAccImageConfigFromSnapshot(common string) string {
+	return fmt.Sprintf(`
+	%s
+	variable "name" {
+		default = "tf-testAccImageConfigFromSnapshot"
+	}
+
+	resource "alicloud_instance" "instance" {
+		image_id = "${data.alicloud_images.default.images.0.id}"
+		availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+		system_disk_category = "cloud_efficiency"
+		instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+		security_groups = ["${alicloud_security_group.default.id}"]
+		instance_name = "${var.name}"
+		vswitch_id = "${alicloud_vswitch.default.id}"
+	}
+
+	data "alicloud_disks" "system" {
+	  instance_id = "${alicloud_instance.instance.id}"
+	  type = "system"
+	}
+
+	resource "alicloud_snapshot" "snapshot" {
+	  disk_id = "${data.alicloud_disks.system.disks.0.id}"
+	  name = "${var.name}"
+	}
+
+	resource "alicloud_image" "image" {
+	  snapshot_id = "${alicloud_snapshot.snapshot.id}"
+	  name = "${var.name}"
+	  platform = "Ubuntu"
+	  architecture = "x86_64"
+	}
+	`, common)
+}
@@ -25,6 +25,13 @@ func resourceAliyunInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: resourceAliyunInstanceCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -35,20 +42,35 @@ func resourceAliyunInstance() *schema.Resource {
 			},
 
 			"image_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: ecsLaunchTemplateDiffSuppressFunc,
 			},
 
 			"instance_type": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInstanceType,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateInstanceType,
+				DiffSuppressFunc: ecsLaunchTemplateDiffSuppressFunc,
 			},
 
 			"security_groups": {
-				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Required: true,
+				Type:             schema.TypeSet,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				Optional:         true,
+				DiffSuppressFunc: ecsLaunchTemplateDiffSuppressFunc,
+			},
+
+			"launch_template_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"launch_template_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
 			},
 
 			"allocate_public_ip": {
@@ -182,8 +204,9 @@ func resourceAliyunInstance() *schema.Resource {
 			},
 
 			"vswitch_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: ecsLaunchTemplateDiffSuppressFunc,
 			},
 
 			"private_ip": {
@@ -313,22 +336,36 @@ func resourceAliyunInstance() *schema.Resource {
 				}),
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
+func resourceAliyunInstanceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := d.GetOk("launch_template_id"); !ok && d.NewValueKnown("launch_template_id") {
+		for _, key := range []string{"image_id", "instance_type", "security_groups"} {
+			if _, ok := d.GetOk(key); !ok && d.NewValueKnown(key) {
+				return fmt.Errorf("%q is required when the instance is not launched from a launch template.", key)
+			}
+		}
+	}
+	return customizeDiffTagsAll(d, meta)
+}
+
 func resourceAliyunInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	ecsService := EcsService{client}
 
-	// Ensure instance_type is valid
-	zoneId, validZones, err := ecsService.DescribeAvailableResources(d, meta, InstanceTypeResource)
-	if err != nil {
-		return err
-	}
-	if err := ecsService.InstanceTypeValidation(d.Get("instance_type").(string), zoneId, validZones); err != nil {
-		return err
+	// Ensure instance_type is valid. An instance launched from a template may take its type from the template.
+	if instanceType := d.Get("instance_type").(string); instanceType != "" {
+		zoneId, validZones, err := ecsService.DescribeAvailableResources(d, meta, InstanceTypeResource)
+		if err != nil {
+			return err
+		}
+		if err := ecsService.InstanceTypeValidation(instanceType, zoneId, validZones); err != nil {
+			return err
+		}
 	}
 
 	args, err := buildAliyunInstanceArgs(d, meta)
@@ -367,7 +404,7 @@ func resourceAliyunInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if err := ecsService.WaitForEcsInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
+	if err := ecsService.WaitForEcsInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
 	}
 
@@ -493,9 +530,7 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil && !NotFoundError(err) {
 		return fmt.Errorf("[ERROR] DescribeTags for instance got error: %#v", err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
-	}
+	setTagsAll(client, d, tagsToMap(tags))
 
 	return nil
 }
@@ -506,11 +541,12 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	d.Partial(true)
 
-	if err := setTags(client, TagResourceInstance, d); err != nil {
+	if err := setTags(client, &ecsService, TagResourceInstance, d); err != nil {
 		log.Printf("[DEBUG] Set tags for instance got error: %#v", err)
 		return fmt.Errorf("Set tags for instance got error: %#v", err)
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("security_groups") {
@@ -577,7 +613,7 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 			}
 		}
 
-		if err := ecsService.WaitForEcsInstance(d.Id(), Stopped, DefaultTimeout); err != nil {
+		if err := ecsService.WaitForEcsInstance(d.Id(), Stopped, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return fmt.Errorf("WaitForInstance %s got error: %#v", Stopped, err)
 		}
 
@@ -605,7 +641,7 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 
 		// Start instance sometimes costs more than 8 minutes when os type is centos.
-		if err := ecsService.WaitForEcsInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
+		if err := ecsService.WaitForEcsInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
 		}
 	}
@@ -673,7 +709,7 @@ func resourceAliyunInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 	deld.InstanceId = d.Id()
 	deld.Force = requests.NewBoolean(true)
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, err := ecsService.DescribeInstanceById(d.Id())
 		if err != nil {
 			if NotFoundError(err) {
@@ -714,6 +750,18 @@ func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.Run
 	ecsService := EcsService{client}
 
 	args := ecs.CreateRunInstancesRequest()
+
+	// An instance launched from a template takes the arguments which are not set and have no default value
+	// from the template.
+	fromTemplate := false
+	if v, ok := d.GetOk("launch_template_id"); ok {
+		fromTemplate = true
+		args.LaunchTemplateId = v.(string)
+		if version, ok := d.GetOk("launch_template_version"); ok {
+			args.LaunchTemplateVersion = requests.NewInteger(version.(int))
+		}
+	}
+
 	args.InstanceType = d.Get("instance_type").(string)
 
 	imageID := d.Get("image_id").(string)
@@ -811,7 +859,7 @@ func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.Run
 	}
 
 	if v := d.Get("role_name").(string); v != "" {
-		if vswitchValue == "" {
+		if vswitchValue == "" && !fromTemplate {
 			return nil, fmt.Errorf("Role name only supported for VPC instance.")
 		}
 		args.RamRoleName = v
@@ -949,7 +997,7 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 		}
 
 		// Ensure instance's image has been replaced successfully.
-		timeout := DefaultTimeoutMedium
+		timeout := int(d.Timeout(schema.TimeoutUpdate).Seconds())
 		for {
 			instance, errDesc := ecsService.DescribeInstanceById(d.Id())
 			if errDesc != nil {
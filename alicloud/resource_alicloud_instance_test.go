@@ -10,6 +10,7 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
@@ -1957,3 +1958,65 @@ func testAccInstanceConfigDataDisk(common string) string {
 	}
 	`, common)
 }
+
+func TestResourceAliyunInstanceCustomizeDiff(t *testing.T) {
+	client := &connectivity.AliyunClient{}
+	diff := func(state *terraform.InstanceState, raw map[string]interface{}) (*terraform.InstanceDiff, error) {
+		c, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("creating the config got an error: %#v", err)
+		}
+		return resourceAliyunInstance().Diff(state, terraform.NewResourceConfig(c), client)
+	}
+
+	if _, err := diff(nil, map[string]interface{}{"instance_type": "ecs.n4.large", "security_groups": []interface{}{"sg-1"}}); err == nil || !strings.Contains(err.Error(), "image_id") {
+		t.Fatalf("expected image_id to be required, got %#v", err)
+	}
+	if _, err := diff(nil, map[string]interface{}{"image_id": config.UnknownVariableValue, "instance_type": "ecs.n4.large",
+		"security_groups": []interface{}{"sg-1"}}); err != nil {
+		t.Fatalf("expected an unknown image_id to be accepted, got %#v", err)
+	}
+	if _, err := diff(nil, map[string]interface{}{"launch_template_id": "lt-1"}); err != nil {
+		t.Fatalf("expected an instance launched from a template to be accepted, got %#v", err)
+	}
+
+	// The arguments which are not set and have no default value are taken from the template, and the other
+	// arguments are still planned to their default values.
+	state := &terraform.InstanceState{ID: "i-1", Attributes: map[string]string{
+		"launch_template_id": "lt-1", "image_id": "m-1", "instance_type": "ecs.n4.large", "security_groups.#": "1",
+		"security_groups.1": "sg-1", "vswitch_id": "vsw-1", "instance_name": "web", "system_disk_size": "80", "deletion_protection": "false",
+	}}
+	d, err := diff(state, map[string]interface{}{"launch_template_id": "lt-1", "instance_name": "ECS-Instance"})
+	if err != nil {
+		t.Fatalf("diffing the instance got an error: %#v", err)
+	}
+	for k := range d.Attributes {
+		if strings.HasPrefix(k, "image_id") || strings.HasPrefix(k, "instance_type") || strings.HasPrefix(k, "security_groups") || k == "vswitch_id" {
+			t.Fatalf("expected %s to be taken from the template, got %#v", k, d.Attributes)
+		}
+	}
+	if attr, ok := d.Attributes["instance_name"]; !ok || attr.New != "ECS-Instance" {
+		t.Fatalf("expected instance_name to be updated, got %#v", d.Attributes)
+	}
+	if attr, ok := d.Attributes["system_disk_size"]; !ok || attr.New != "40" {
+		t.Fatalf("expected system_disk_size to be planned to its default value, got %#v", d.Attributes)
+	}
+
+	// Removing an argument of an instance not launched from a template plans its default value.
+	state = &terraform.InstanceState{ID: "i-1", Attributes: map[string]string{
+		"image_id": "m-1", "instance_type": "ecs.n4.large", "security_groups.#": "1", "security_groups.1": "sg-1",
+		"instance_name": "ECS-Instance", "internet_charge_type": "PayByTraffic", "internet_max_bandwidth_out": "5",
+		"system_disk_category": "cloud_efficiency", "system_disk_size": "40", "instance_charge_type": "PrePaid",
+		"spot_strategy": "NoSpot", "deletion_protection": "false",
+	}}
+	d, err = diff(state, map[string]interface{}{"image_id": "m-1", "instance_type": "ecs.n4.large", "security_groups": []interface{}{"sg-1"}})
+	if err != nil {
+		t.Fatalf("diffing the instance got an error: %#v", err)
+	}
+	if attr, ok := d.Attributes["internet_max_bandwidth_out"]; !ok || attr.New != "0" {
+		t.Fatalf("expected internet_max_bandwidth_out to be planned to 0, got %#v", d.Attributes)
+	}
+	if attr, ok := d.Attributes["instance_charge_type"]; !ok || attr.New != string(PostPaid) {
+		t.Fatalf("expected instance_charge_type to be planned to PostPaid, got %#v", d.Attributes)
+	}
+}
@@ -0,0 +1,247 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudKVStoreAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudKVStoreAccountCreate,
+		Read:   resourceAlicloudKVStoreAccountRead,
+		Update: resourceAlicloudKVStoreAccountUpdate,
+		Delete: resourceAlicloudKVStoreAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(DBAccountNormal)}),
+				Default:      string(DBAccountNormal),
+			},
+
+			"privilege": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(KVStoreRoleReadOnly),
+					string(KVStoreRoleReadWrite),
+					string(KVStoreRoleRepl),
+				}),
+				Default: string(KVStoreRoleReadWrite),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudKVStoreAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	kvstoreService := KvstoreService{client}
+	request := r_kvstore.CreateCreateAccountRequest()
+	request.InstanceId = d.Get("instance_id").(string)
+	request.AccountName = d.Get("name").(string)
+	request.AccountPassword = d.Get("password").(string)
+	request.AccountType = d.Get("type").(string)
+
+	if v, ok := d.GetOk("description"); ok && v.(string) != "" {
+		request.AccountDescription = v.(string)
+	}
+	timeout := int(d.Timeout(schema.TimeoutCreate).Seconds())
+	// wait instance status is Normal before modifying
+	if err := kvstoreService.WaitForRKVInstance(request.InstanceId, Normal, timeout); err != nil {
+		return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
+	}
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		args := request
+		_, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+			return rkvClient.CreateAccount(args)
+		})
+		if err != nil {
+			if IsExceptedError(err, KVStoreAccountNameDuplicate) {
+				return resource.NonRetryableError(fmt.Errorf("The account %s has already existed. Please import it using ID '%s:%s' or specify a new 'name' and try again.",
+					args.AccountName, args.InstanceId, args.AccountName))
+			} else if IsExceptedError(err, KVStoreInvalidStatus) {
+				return resource.RetryableError(fmt.Errorf("Create KVStore account got an error: %#v.", err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Create KVStore account got an error: %#v.", err))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", request.InstanceId, COLON_SEPARATED, request.AccountName))
+
+	if err := kvstoreService.WaitForRKVAccount(request.InstanceId, request.AccountName, Available, timeout); err != nil {
+		return fmt.Errorf("Wait KVStore account %s got an error: %#v.", Available, err)
+	}
+
+	return resourceAlicloudKVStoreAccountUpdate(d, meta)
+}
+
+func resourceAlicloudKVStoreAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	kvstoreService := KvstoreService{client}
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	account, err := kvstoreService.DescribeRKVAccount(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Describe KVStore account got an error: %#v", err)
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("name", account.AccountName)
+	d.Set("type", account.AccountType)
+	d.Set("description", account.AccountDescription)
+	if len(account.DatabasePrivileges.DatabasePrivilege) > 0 {
+		d.Set("privilege", account.DatabasePrivileges.DatabasePrivilege[0].AccountPrivilege)
+	}
+
+	return nil
+}
+
+func resourceAlicloudKVStoreAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	kvstoreService := KvstoreService{client}
+	d.Partial(true)
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+	instanceId := parts[0]
+	accountName := parts[1]
+	timeout := int(d.Timeout(schema.TimeoutUpdate).Seconds())
+
+	if d.HasChange("description") && !d.IsNewResource() {
+		request := r_kvstore.CreateModifyAccountDescriptionRequest()
+		request.InstanceId = instanceId
+		request.AccountName = accountName
+		request.AccountDescription = d.Get("description").(string)
+
+		_, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+			return rkvClient.ModifyAccountDescription(request)
+		})
+		if err != nil {
+			return fmt.Errorf("ModifyAccountDescription got an error: %#v", err)
+		}
+		d.SetPartial("description")
+	}
+
+	// the account is created with the read and write privilege
+	if d.HasChange("privilege") && !(d.IsNewResource() && d.Get("privilege").(string) == string(KVStoreRoleReadWrite)) {
+		if err := kvstoreService.WaitForRKVInstance(instanceId, Normal, timeout); err != nil {
+			return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
+		}
+		request := r_kvstore.CreateGrantAccountPrivilegeRequest()
+		request.InstanceId = instanceId
+		request.AccountName = accountName
+		request.AccountPrivilege = d.Get("privilege").(string)
+
+		_, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+			return rkvClient.GrantAccountPrivilege(request)
+		})
+		if err != nil {
+			return fmt.Errorf("GrantAccountPrivilege got an error: %#v", err)
+		}
+		if err := kvstoreService.WaitForRKVAccount(instanceId, accountName, Available, timeout); err != nil {
+			return fmt.Errorf("Wait KVStore account %s got an error: %#v.", Available, err)
+		}
+		d.SetPartial("privilege")
+	}
+
+	if d.HasChange("password") && !d.IsNewResource() {
+		if err := kvstoreService.WaitForRKVInstance(instanceId, Normal, timeout); err != nil {
+			return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
+		}
+		request := r_kvstore.CreateResetAccountPasswordRequest()
+		request.InstanceId = instanceId
+		request.AccountName = accountName
+		request.AccountPassword = d.Get("password").(string)
+
+		_, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+			return rkvClient.ResetAccountPassword(request)
+		})
+		if err != nil {
+			return fmt.Errorf("Error reset KVStore account password error: %#v", err)
+		}
+		if err := kvstoreService.WaitForRKVAccount(instanceId, accountName, Available, timeout); err != nil {
+			return fmt.Errorf("Wait KVStore account %s got an error: %#v.", Available, err)
+		}
+		d.SetPartial("password")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudKVStoreAccountRead(d, meta)
+}
+
+func resourceAlicloudKVStoreAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	kvstoreService := KvstoreService{client}
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+
+	request := r_kvstore.CreateDeleteAccountRequest()
+	request.InstanceId = parts[0]
+	request.AccountName = parts[1]
+
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+			return rkvClient.DeleteAccount(request)
+		})
+		if err != nil {
+			if IsExceptedErrors(err, []string{InvalidKVStoreInstanceIdNotFound, InvalidAccountNameNotFound}) {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("Delete KVStore account got an error: %#v.", err))
+		}
+
+		if _, err := kvstoreService.DescribeRKVAccount(parts[0], parts[1]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Delete KVStore account %s timeout.", d.Id()))
+	})
+}
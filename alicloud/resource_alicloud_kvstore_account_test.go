@@ -0,0 +1,122 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudKVStoreAccount_basic(t *testing.T) {
+	var account r_kvstore.Account
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_kvstore_account.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKVStoreAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVStoreAccountConfig(string(KVStoreRoleReadOnly), "from terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKVStoreAccountExists("alicloud_kvstore_account.foo", &account),
+					resource.TestCheckResourceAttr("alicloud_kvstore_account.foo", "name", "tftestaccount"),
+					resource.TestCheckResourceAttr("alicloud_kvstore_account.foo", "type", string(DBAccountNormal)),
+					resource.TestCheckResourceAttr("alicloud_kvstore_account.foo", "privilege", string(KVStoreRoleReadOnly)),
+					resource.TestCheckResourceAttr("alicloud_kvstore_account.foo", "description", "from terraform"),
+				),
+			},
+			{
+				Config: testAccKVStoreAccountConfig(string(KVStoreRoleReadWrite), "from terraform update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKVStoreAccountExists("alicloud_kvstore_account.foo", &account),
+					resource.TestCheckResourceAttr("alicloud_kvstore_account.foo", "privilege", string(KVStoreRoleReadWrite)),
+					resource.TestCheckResourceAttr("alicloud_kvstore_account.foo", "description", "from terraform update"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckKVStoreAccountExists(n string, d *r_kvstore.Account) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KVStore Account ID is set")
+		}
+
+		client := testAccProvider.Meta().(*connectivity.AliyunClient)
+		kvstoreService := KvstoreService{client}
+		parts := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		account, err := kvstoreService.DescribeRKVAccount(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*d = *account
+		return nil
+	}
+}
+
+func testAccCheckKVStoreAccountDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AliyunClient)
+	kvstoreService := KvstoreService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_kvstore_account" {
+			continue
+		}
+
+		parts := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		if _, err := kvstoreService.DescribeRKVAccount(parts[0], parts[1]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Error KVStore account %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccKVStoreAccountConfig(privilege, description string) string {
+	return fmt.Sprintf(`
+	data "alicloud_zones" "default" {
+		available_resource_creation = "KVStore"
+	}
+	variable "name" {
+		default = "tf-testAccKVStoreAccount"
+	}
+
+	resource "alicloud_kvstore_instance" "foo" {
+		availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+		instance_name  = "${var.name}"
+		instance_type = "Redis"
+		instance_class = "%s"
+		engine_version = "4.0"
+	}
+
+	resource "alicloud_kvstore_account" "foo" {
+		instance_id = "${alicloud_kvstore_instance.foo.id}"
+		name = "tftestaccount"
+		password = "YourPassword_123"
+		privilege = "%s"
+		description = "%s"
+	}
+	`, redisInstanceClassForTest, privilege, description)
+}
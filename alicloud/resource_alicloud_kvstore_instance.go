@@ -24,6 +24,13 @@ func resourceAlicloudKVStoreInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffTagsAll,
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -102,6 +109,39 @@ func resourceAlicloudKVStoreInstance() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+
+			"parameters": {
+				Type: schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+
+			"shard_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"architecture_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -126,7 +166,7 @@ func resourceAlicloudKVStoreInstanceCreate(d *schema.ResourceData, meta interfac
 	d.SetId(resp.InstanceId)
 
 	// wait instance status change from Creating to Normal
-	if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+	if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
 	}
 
@@ -138,9 +178,15 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 	kvstoreService := KvstoreService{client}
 	d.Partial(true)
 
+	if err := setTags(client, &kvstoreService, TagResourceKVStoreInstance, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
 	if d.HasChange("security_ips") {
 		// wait instance status is Normal before modifying
-		if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+		if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
 		}
 		request := r_kvstore.CreateModifySecurityIpsRequest()
@@ -159,11 +205,18 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 		}
 		d.SetPartial("security_ips")
 		// wait instance status is Normal after modifying
-		if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+		if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
 		}
 	}
 
+	if d.HasChange("parameters") {
+		if err := kvstoreService.ModifyRKVInstanceParameters(d, "parameters", int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return err
+		}
+		d.SetPartial("parameters")
+	}
+
 	if d.IsNewResource() {
 		d.Partial(false)
 		return resourceAlicloudKVStoreInstanceRead(d, meta)
@@ -171,7 +224,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	if d.HasChange("instance_class") {
 		// wait instance status is Normal before modifying
-		if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+		if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
 		}
 		request := r_kvstore.CreateModifyInstanceSpecRequest()
@@ -184,23 +237,9 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return err
 		}
-		// wait instance status is Normal after modifying
-		if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
-			return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
-		}
-		// There needs more time to sync instance class update
-		if err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-			instance, err := kvstoreService.DescribeRKVInstanceById(d.Id())
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if instance.InstanceClass != request.InstanceClass {
-				return resource.RetryableError(fmt.Errorf("Waitting for instance class is changed timeout. "+
-					"Expect instance class %s, got %s.", instance.InstanceClass, request.InstanceClass))
-			}
-			return nil
-		}); err != nil {
-			return err
+		// wait instance passing through the spec changing, which takes a long time for cluster instances
+		if err := kvstoreService.WaitForRKVInstanceClass(d.Id(), request.InstanceClass, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return fmt.Errorf("WaitForInstance class %s got error: %#v", request.InstanceClass, err)
 		}
 
 		d.SetPartial("instance_class")
@@ -234,7 +273,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 				return fmt.Errorf("TransformToPrePaid got an error: %#v", err)
 			}
 			// wait instance status is Normal after modifying
-			if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+			if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 				return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
 			}
 			d.SetPartial("instance_charge_type")
@@ -244,7 +283,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	if update {
 		// wait instance status is Normal before modifying
-		if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+		if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
 		}
 		_, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
@@ -256,7 +295,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 		d.SetPartial("instance_name")
 		d.SetPartial("password")
 		// wait instance status is Normal after modifying
-		if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+		if err := kvstoreService.WaitForRKVInstance(d.Id(), Normal, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
 		}
 	}
@@ -286,6 +325,23 @@ func resourceAlicloudKVStoreInstanceRead(d *schema.ResourceData, meta interface{
 	d.Set("connection_domain", instance.ConnectionDomain)
 	d.Set("private_ip", instance.PrivateIp)
 	d.Set("security_ips", strings.Split(instance.SecurityIPList, COMMA_SEPARATED))
+	d.Set("architecture_type", instance.ArchitectureType)
+
+	if instance.ArchitectureType == string(KVStoreCluster) {
+		shardCount, err := kvstoreService.DescribeRKVInstanceShardCount(d.Id())
+		if err != nil {
+			return fmt.Errorf("DescribeRKVInstanceShardCount got an error: %#v", err)
+		}
+		d.Set("shard_count", shardCount)
+	}
+
+	if err := kvstoreService.RefreshRKVInstanceParameters(d, "parameters"); err != nil {
+		return err
+	}
+
+	if err := readTags(client, &kvstoreService, TagResourceKVStoreInstance, d); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -307,7 +363,7 @@ func resourceAlicloudKVStoreInstanceDelete(d *schema.ResourceData, meta interfac
 	request := r_kvstore.CreateDeleteInstanceRequest()
 	request.InstanceId = d.Id()
 
-	return resource.Retry(8*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.DeleteInstance(request)
 		})
@@ -345,6 +401,10 @@ func buildKVStoreCreateRequest(d *schema.ResourceData, meta interface{}) (*r_kvs
 	request.ChargeType = Trim(d.Get("instance_charge_type").(string))
 	request.Password = Trim(d.Get("password").(string))
 	request.BackupId = Trim(d.Get("backup_id").(string))
+	if v, ok := d.GetOk("shard_count"); ok {
+		// ShardCount is not supported by the SDK yet
+		request.QueryParams["ShardCount"] = strconv.Itoa(v.(int))
+	}
 
 	if PayType(request.ChargeType) == PrePaid {
 		request.Period = strconv.Itoa(d.Get("period").(int))
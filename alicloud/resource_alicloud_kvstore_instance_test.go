@@ -23,6 +23,7 @@ var redisInstanceClassForTestUpdateClass = "redis.master.mid.default"
 var memcacheInstanceConnectionDomainRegexp = regexp.MustCompile("^m-[a-z0-9]+.memcache[.a-z-0-9]*.rds.aliyuncs.com")
 var memcacheInstanceClassForTest = "memcache.master.small.default"
 var memcacheInstanceClassForTestUpdateClass = "memcache.master.mid.default"
+var redisClusterInstanceClassForTest = "redis.logic.sharding.1g.2db.0rodb.4proxy.default"
 
 func init() {
 	resource.AddTestSweepers("alicloud_kvstore_instance", &resource.Sweeper{
@@ -1172,6 +1173,68 @@ func TestAccAlicloudKVStoreMemcacheInstance_vpcUpdateAll(t *testing.T) {
 
 }
 
+func TestAccAlicloudKVStoreRedisInstance_parameters(t *testing.T) {
+	var instance r_kvstore.DBInstanceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_kvstore_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKVStoreInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVStoreInstance_parameters("volatile-lru", "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKVStoreInstanceExists("alicloud_kvstore_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_kvstore_instance.foo", "parameters.#", "2"),
+					resource.TestCheckResourceAttr("alicloud_kvstore_instance.foo", "architecture_type", string(KVStoreStandard)),
+				),
+			},
+			{
+				Config: testAccKVStoreInstance_parameters("allkeys-lru", "300"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKVStoreInstanceExists("alicloud_kvstore_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_kvstore_instance.foo", "parameters.#", "2"),
+				),
+			},
+		},
+	})
+
+}
+
+func TestAccAlicloudKVStoreRedisInstance_cluster(t *testing.T) {
+	var instance r_kvstore.DBInstanceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_kvstore_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKVStoreInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVStoreInstance_classic(string(KVStoreRedis), redisClusterInstanceClassForTest, string(KVStore4Dot0)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKVStoreInstanceExists("alicloud_kvstore_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_kvstore_instance.foo", "instance_class", redisClusterInstanceClassForTest),
+					resource.TestCheckResourceAttr("alicloud_kvstore_instance.foo", "architecture_type", string(KVStoreCluster)),
+					resource.TestCheckResourceAttr("alicloud_kvstore_instance.foo", "shard_count", "2"),
+				),
+			},
+		},
+	})
+
+}
+
 func testAccCheckKVStoreInstanceExists(n string, d *r_kvstore.DBInstanceAttribute) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1434,3 +1497,29 @@ func testAccKVStoreInstance_vpcUpdateAll(common, instanceClass, instanceType, en
 	}
 	`, common, instanceClass, instanceType, engineVersion)
 }
+
+func testAccKVStoreInstance_parameters(policy, timeout string) string {
+	return fmt.Sprintf(`
+	data "alicloud_zones" "default" {
+		available_resource_creation = "KVStore"
+	}
+	variable "name" {
+		default = "tf-testAccKVStoreInstance_parameters"
+	}
+
+	resource "alicloud_kvstore_instance" "foo" {
+		availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+		instance_name  = "${var.name}"
+		instance_type = "Redis"
+		instance_class = "%s"
+		engine_version = "4.0"
+		parameters = [{
+			name = "maxmemory-policy"
+			value = "%s"
+		},{
+			name = "timeout"
+			value = "%s"
+		}]
+	}
+	`, redisInstanceClassForTest, policy, timeout)
+}
@@ -0,0 +1,466 @@
+package alicloud
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// launchTemplateDataKeys are the arguments of a launch template which are saved in its versions.
+// Changing any of them creates a new version of the template.
+var launchTemplateDataKeys = []string{
+	"version_description", "image_id", "instance_type", "security_group_id", "vswitch_id", "availability_zone",
+	"instance_name", "description", "host_name", "internet_charge_type", "internet_max_bandwidth_in",
+	"internet_max_bandwidth_out", "instance_charge_type", "system_disk_category", "system_disk_size", "data_disks",
+	"user_data", "key_name", "role_name", "spot_strategy", "spot_price_limit", "security_enhancement_strategy",
+	"instance_tags",
+}
+
+func resourceAliyunLaunchTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunLaunchTemplateCreate,
+		Read:   resourceAliyunLaunchTemplateRead,
+		Update: resourceAliyunLaunchTemplateUpdate,
+		Delete: resourceAliyunLaunchTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDiskName,
+			},
+			"version_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDiskDescription,
+			},
+			"update_default_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateInstanceType,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instance_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateInstanceName,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateInstanceDescription,
+			},
+			"host_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"internet_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateInternetChargeType,
+			},
+			"internet_max_bandwidth_in": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(1, 200),
+			},
+			"internet_max_bandwidth_out": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateIntegerInRange(0, 100),
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateInstanceChargeType,
+			},
+			"system_disk_category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDiskCategory,
+			},
+			"system_disk_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"data_disks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 16,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateDiskName,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"category": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateDiskCategory,
+							Default:      DiskCloudEfficiency,
+						},
+						"encrypted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"delete_with_instance": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateDiskDescription,
+						},
+					},
+				},
+			},
+			"user_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"key_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"spot_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateInstanceSpotStrategy,
+			},
+			"spot_price_limit": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"security_enhancement_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(ActiveSecurityEnhancementStrategy),
+					string(DeactiveSecurityEnhancementStrategy),
+				}),
+			},
+			"instance_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"default_version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"latest_version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAliyunLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	args := buildAliyunLaunchTemplateArgs(d)
+	args.LaunchTemplateName = d.Get("name").(string)
+
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.CreateLaunchTemplate(args)
+	})
+	if err != nil {
+		return fmt.Errorf("CreateLaunchTemplate got an error: %#v", err)
+	}
+	resp, _ := raw.(*ecs.CreateLaunchTemplateResponse)
+	if resp == nil {
+		return fmt.Errorf("CreateLaunchTemplate got a nil response: %#v", resp)
+	}
+
+	d.SetId(resp.LaunchTemplateId)
+
+	return resourceAliyunLaunchTemplateRead(d, meta)
+}
+
+func resourceAliyunLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	template, err := ecsService.DescribeLaunchTemplateById(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error DescribeLaunchTemplates: %#v", err)
+	}
+
+	d.Set("name", template.LaunchTemplateName)
+	d.Set("default_version_number", template.DefaultVersionNumber)
+	d.Set("latest_version_number", template.LatestVersionNumber)
+
+	// The arguments describe the latest version, which is the one created by the last apply.
+	version, err := ecsService.DescribeLaunchTemplateVersion(d.Id(), template.LatestVersionNumber)
+	if err != nil {
+		return fmt.Errorf("Error DescribeLaunchTemplateVersions: %#v", err)
+	}
+	data := version.LaunchTemplateData
+
+	d.Set("version_description", version.VersionDescription)
+	d.Set("image_id", data.ImageId)
+	d.Set("instance_type", data.InstanceType)
+	d.Set("security_group_id", data.SecurityGroupId)
+	d.Set("vswitch_id", data.VSwitchId)
+	d.Set("availability_zone", data.ZoneId)
+	d.Set("instance_name", data.InstanceName)
+	d.Set("description", data.Description)
+	d.Set("host_name", data.HostName)
+	d.Set("internet_charge_type", data.InternetChargeType)
+	d.Set("internet_max_bandwidth_in", data.InternetMaxBandwidthIn)
+	d.Set("internet_max_bandwidth_out", data.InternetMaxBandwidthOut)
+	d.Set("instance_charge_type", data.InstanceChargeType)
+	d.Set("system_disk_category", data.SystemDiskCategory)
+	d.Set("system_disk_size", data.SystemDiskSize)
+	d.Set("user_data", userDataHashSum(data.UserData))
+	d.Set("key_name", data.KeyPairName)
+	d.Set("role_name", data.RamRoleName)
+	d.Set("spot_strategy", data.SpotStrategy)
+	d.Set("spot_price_limit", data.SpotPriceLimit)
+	d.Set("security_enhancement_strategy", data.SecurityEnhancementStrategy)
+
+	var disks []map[string]interface{}
+	for _, disk := range data.DataDisks.DataDisk {
+		encrypted, _ := strconv.ParseBool(disk.Encrypted)
+		disks = append(disks, map[string]interface{}{
+			"name":                 disk.DiskName,
+			"size":                 disk.Size,
+			"category":             disk.Category,
+			"encrypted":            encrypted,
+			"snapshot_id":          disk.SnapshotId,
+			"delete_with_instance": disk.DeleteWithInstance,
+			"description":          disk.Description,
+		})
+	}
+	if err := d.Set("data_disks", disks); err != nil {
+		return err
+	}
+
+	tags := make(map[string]string)
+	for _, t := range data.Tags.InstanceTag {
+		tags[t.Key] = t.Value
+	}
+	d.Set("instance_tags", tags)
+
+	return nil
+}
+
+func resourceAliyunLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	d.Partial(true)
+
+	changed := false
+	for _, key := range launchTemplateDataKeys {
+		if d.HasChange(key) {
+			changed = true
+			break
+		}
+	}
+
+	if changed {
+		args := launchTemplateVersionArgs(buildAliyunLaunchTemplateArgs(d))
+		args.LaunchTemplateId = d.Id()
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.CreateLaunchTemplateVersion(args)
+		})
+		if err != nil {
+			return fmt.Errorf("CreateLaunchTemplateVersion got an error: %#v", err)
+		}
+		resp, _ := raw.(*ecs.CreateLaunchTemplateVersionResponse)
+		if resp == nil {
+			return fmt.Errorf("CreateLaunchTemplateVersion got a nil response: %#v", resp)
+		}
+		for _, key := range launchTemplateDataKeys {
+			d.SetPartial(key)
+		}
+
+		if d.Get("update_default_version").(bool) {
+			req := ecs.CreateModifyLaunchTemplateDefaultVersionRequest()
+			req.LaunchTemplateId = d.Id()
+			req.DefaultVersionNumber = requests.NewInteger(resp.LaunchTemplateVersionNumber)
+			_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+				return ecsClient.ModifyLaunchTemplateDefaultVersion(req)
+			})
+			if err != nil {
+				return fmt.Errorf("ModifyLaunchTemplateDefaultVersion got an error: %#v", err)
+			}
+		}
+	}
+
+	d.Partial(false)
+
+	return resourceAliyunLaunchTemplateRead(d, meta)
+}
+
+func resourceAliyunLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	req := ecs.CreateDeleteLaunchTemplateRequest()
+	req.LaunchTemplateId = d.Id()
+	_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DeleteLaunchTemplate(req)
+	})
+	if err != nil && !NotFoundError(err) {
+		return fmt.Errorf("DeleteLaunchTemplate got an error: %#v", err)
+	}
+	return nil
+}
+
+func buildAliyunLaunchTemplateArgs(d *schema.ResourceData) *ecs.CreateLaunchTemplateRequest {
+	args := ecs.CreateCreateLaunchTemplateRequest()
+	args.VersionDescription = d.Get("version_description").(string)
+	args.ImageId = d.Get("image_id").(string)
+	args.InstanceType = d.Get("instance_type").(string)
+	args.SecurityGroupId = d.Get("security_group_id").(string)
+	args.VSwitchId = d.Get("vswitch_id").(string)
+	args.ZoneId = d.Get("availability_zone").(string)
+	args.InstanceName = d.Get("instance_name").(string)
+	args.Description = d.Get("description").(string)
+	args.HostName = d.Get("host_name").(string)
+	args.InternetChargeType = d.Get("internet_charge_type").(string)
+	if v, ok := d.GetOk("internet_max_bandwidth_in"); ok {
+		args.InternetMaxBandwidthIn = requests.NewInteger(v.(int))
+	}
+	if v, ok := d.GetOk("internet_max_bandwidth_out"); ok {
+		args.InternetMaxBandwidthOut = requests.NewInteger(v.(int))
+	}
+	args.InstanceChargeType = d.Get("instance_charge_type").(string)
+	args.SystemDiskCategory = d.Get("system_disk_category").(string)
+	if v, ok := d.GetOk("system_disk_size"); ok {
+		args.SystemDiskSize = requests.NewInteger(v.(int))
+	}
+	if v := d.Get("user_data").(string); v != "" {
+		args.UserData = base64.StdEncoding.EncodeToString([]byte(v))
+	}
+	args.KeyPairName = d.Get("key_name").(string)
+	args.RamRoleName = d.Get("role_name").(string)
+	args.SpotStrategy = d.Get("spot_strategy").(string)
+	if v, ok := d.GetOk("spot_price_limit"); ok {
+		args.SpotPriceLimit = requests.NewFloat(v.(float64))
+	}
+	args.SecurityEnhancementStrategy = d.Get("security_enhancement_strategy").(string)
+
+	if v, ok := d.GetOk("data_disks"); ok {
+		var disks []ecs.CreateLaunchTemplateDataDisk
+		for _, raw := range v.([]interface{}) {
+			disk := raw.(map[string]interface{})
+			disks = append(disks, ecs.CreateLaunchTemplateDataDisk{
+				DiskName:           disk["name"].(string),
+				Size:               strconv.Itoa(disk["size"].(int)),
+				Category:           disk["category"].(string),
+				Encrypted:          strconv.FormatBool(disk["encrypted"].(bool)),
+				SnapshotId:         disk["snapshot_id"].(string),
+				DeleteWithInstance: strconv.FormatBool(disk["delete_with_instance"].(bool)),
+				Description:        disk["description"].(string),
+			})
+		}
+		args.DataDisk = &disks
+	}
+
+	if v, ok := d.GetOk("instance_tags"); ok {
+		var tags []ecs.CreateLaunchTemplateTag
+		for key, value := range v.(map[string]interface{}) {
+			tags = append(tags, ecs.CreateLaunchTemplateTag{Key: key, Value: value.(string)})
+		}
+		args.Tag = &tags
+	}
+	return args
+}
+
+// launchTemplateVersionArgs converts the arguments of a launch template to the ones of a new version of it.
+func launchTemplateVersionArgs(args *ecs.CreateLaunchTemplateRequest) *ecs.CreateLaunchTemplateVersionRequest {
+	req := ecs.CreateCreateLaunchTemplateVersionRequest()
+	req.VersionDescription = args.VersionDescription
+	req.ImageId = args.ImageId
+	req.InstanceType = args.InstanceType
+	req.SecurityGroupId = args.SecurityGroupId
+	req.VSwitchId = args.VSwitchId
+	req.ZoneId = args.ZoneId
+	req.InstanceName = args.InstanceName
+	req.Description = args.Description
+	req.HostName = args.HostName
+	req.InternetChargeType = args.InternetChargeType
+	req.InternetMaxBandwidthIn = args.InternetMaxBandwidthIn
+	req.InternetMaxBandwidthOut = args.InternetMaxBandwidthOut
+	req.InstanceChargeType = args.InstanceChargeType
+	req.SystemDiskCategory = args.SystemDiskCategory
+	req.SystemDiskSize = args.SystemDiskSize
+	req.UserData = args.UserData
+	req.KeyPairName = args.KeyPairName
+	req.RamRoleName = args.RamRoleName
+	req.SpotStrategy = args.SpotStrategy
+	req.SpotPriceLimit = args.SpotPriceLimit
+	req.SecurityEnhancementStrategy = args.SecurityEnhancementStrategy
+
+	if args.DataDisk != nil {
+		var disks []ecs.CreateLaunchTemplateVersionDataDisk
+		for _, disk := range *args.DataDisk {
+			disks = append(disks, ecs.CreateLaunchTemplateVersionDataDisk(disk))
+		}
+		req.DataDisk = &disks
+	}
+	if args.Tag != nil {
+		var tags []ecs.CreateLaunchTemplateVersionTag
+		for _, tag := range *args.Tag {
+			tags = append(tags, ecs.CreateLaunchTemplateVersionTag(tag))
+		}
+		req.Tag = &tags
+	}
+	return req
+}
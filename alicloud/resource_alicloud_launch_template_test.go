@@ -0,0 +1,190 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudLaunchTemplate_basic(t *testing.T) {
+	var v ecs.LaunchTemplateSet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_launch_template.template",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig(EcsInstanceCommonTestCase, 40, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists("alicloud_launch_template.template", &v),
+					resource.TestCheckResourceAttr("alicloud_launch_template.template", "name", "tf-testAccLaunchTemplateConfig"),
+					resource.TestCheckResourceAttr("alicloud_launch_template.template", "system_disk_size", "40"),
+					resource.TestCheckResourceAttr("alicloud_launch_template.template", "data_disks.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_launch_template.template", "instance_tags.%", "1"),
+					resource.TestCheckResourceAttr("alicloud_launch_template.template", "default_version_number", "1"),
+					resource.TestCheckResourceAttr("alicloud_launch_template.template", "latest_version_number", "1"),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateConfig(EcsInstanceCommonTestCase, 50, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists("alicloud_launch_template.template", &v),
+					resource.TestCheckResourceAttr("alicloud_launch_template.template", "system_disk_size", "50"),
+					resource.TestCheckResourceAttr("alicloud_launch_template.template", "default_version_number", "1"),
+					resource.TestCheckResourceAttr("alicloud_launch_template.template", "latest_version_number", "2"),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateConfig(EcsInstanceCommonTestCase, 60, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists("alicloud_launch_template.template", &v),
+					resource.TestCheckResourceAttr("alicloud_launch_template.template", "system_disk_size", "60"),
+					resource.TestCheckResourceAttr("alicloud_launch_template.template", "default_version_number", "3"),
+					resource.TestCheckResourceAttr("alicloud_launch_template.template", "latest_version_number", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudLaunchTemplate_instance(t *testing.T) {
+	var v ecs.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_instance.instance",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateInstanceConfig(EcsInstanceCommonTestCase),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("alicloud_instance.instance", &v),
+					resource.TestCheckResourceAttr("alicloud_instance.instance", "instance_name", "tf-testAccLaunchTemplateInstanceConfig"),
+					resource.TestCheckResourceAttr("alicloud_instance.instance", "system_disk_size", "50"),
+					resource.TestCheckResourceAttrPair("alicloud_instance.instance", "image_id", "alicloud_launch_template.template", "image_id"),
+					resource.TestCheckResourceAttrPair("alicloud_instance.instance", "instance_type", "alicloud_launch_template.template", "instance_type"),
+					resource.TestCheckResourceAttrPair("alicloud_instance.instance", "vswitch_id", "alicloud_vswitch.default", "id"),
+					resource.TestCheckResourceAttr("alicloud_instance.instance", "security_groups.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchTemplateExists(n string, template *ecs.LaunchTemplateSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Launch Template ID is set")
+		}
+
+		client := testAccProvider.Meta().(*connectivity.AliyunClient)
+		ecsService := EcsService{client}
+
+		v, err := ecsService.DescribeLaunchTemplateById(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("While checking launch template existing, describing launch template got an error: %#v.", err)
+		}
+
+		*template = v
+		return nil
+	}
+}
+
+func testAccCheckLaunchTemplateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_launch_template" {
+			continue
+		}
+
+		if _, err := ecsService.DescribeLaunchTemplateById(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("While checking launch template destroy, describing launch template got an error: %#v.", err)
+		}
+		return fmt.Errorf("ECS Launch Template %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLaunchTemplateConfig(common string, systemDiskSize int, updateDefaultVersion bool) string {
+	return fmt.Sprintf(`
+	%s
+	variable "name" {
+		default = "tf-testAccLaunchTemplateConfig"
+	}
+
+	resource "alicloud_launch_template" "template" {
+	  name = "${var.name}"
+	  version_description = "system disk of %dGiB"
+	  update_default_version = %t
+	  image_id = "${data.alicloud_images.default.images.0.id}"
+	  instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+	  security_group_id = "${alicloud_security_group.default.id}"
+	  vswitch_id = "${alicloud_vswitch.default.id}"
+	  instance_name = "${var.name}"
+	  system_disk_category = "cloud_efficiency"
+	  system_disk_size = %d
+	  data_disks = [
+	    {
+	      size = 20
+	      category = "cloud_efficiency"
+	    }
+	  ]
+	  instance_tags {
+	    Name = "TerraformTest"
+	  }
+	}
+	`, common, systemDiskSize, updateDefaultVersion, systemDiskSize)
+}
+
+func testAccLaunchTemplateInstanceConfig(common string) string {
+	return fmt.Sprintf(`
+	%s
+	variable "name" {
+		default = "tf-testAccLaunchTemplateInstanceConfig"
+	}
+
+	resource "alicloud_launch_template" "template" {
+	  name = "${var.name}"
+	  image_id = "${data.alicloud_images.default.images.0.id}"
+	  instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+	  security_group_id = "${alicloud_security_group.default.id}"
+	  vswitch_id = "${alicloud_vswitch.default.id}"
+	  instance_name = "${var.name}"
+	  system_disk_category = "cloud_efficiency"
+	  system_disk_size = 50
+	}
+
+	resource "alicloud_instance" "instance" {
+	  launch_template_id = "${alicloud_launch_template.template.id}"
+	  launch_template_version = "${alicloud_launch_template.template.default_version_number}"
+	}
+	`, common)
+}
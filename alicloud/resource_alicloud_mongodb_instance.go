@@ -0,0 +1,468 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudMongoDBInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudMongoDBInstanceCreate,
+		Read:   resourceAlicloudMongoDBInstanceRead,
+		Update: resourceAlicloudMongoDBInstanceUpdate,
+		Delete: resourceAlicloudMongoDBInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"engine_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_instance_class": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"db_instance_storage": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(10, 3000),
+			},
+			"replication_factor": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedIntValue([]int{3, 5, 7}),
+			},
+			"storage_engine": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(WiredTiger), string(RocksDB)}),
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      PostPaid,
+				ValidateFunc: validateInstanceChargeType,
+			},
+			"period": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateFunc:     validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+				DiffSuppressFunc: rkvPostPaidDiffSuppressFunc,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDBInstanceName,
+			},
+			"security_ip_list": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"account_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validateRKVPassword,
+			},
+			"backup_period": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"backup_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue(BACKUP_TIME),
+			},
+			"retention_period": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudMongoDBInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	mongoDBService := MongoDBService{client}
+
+	request, err := buildMongoDBCreateRequest(d, meta)
+	if err != nil {
+		return err
+	}
+
+	raw, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+		return ddsClient.CreateDBInstance(request)
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Alicloud MongoDB instance: %#v", err)
+	}
+	resp, _ := raw.(*dds.CreateDBInstanceResponse)
+	d.SetId(resp.DBInstanceId)
+
+	// wait instance status change from Creating to Running
+	if err := mongoDBService.WaitForMongoDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+	}
+
+	return resourceAlicloudMongoDBInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudMongoDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	mongoDBService := MongoDBService{client}
+
+	instance, err := mongoDBService.DescribeMongoDBInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe MongoDB InstanceAttribute: %#v", err)
+	}
+
+	d.Set("name", instance.DBInstanceDescription)
+	d.Set("engine_version", instance.EngineVersion)
+	d.Set("db_instance_class", instance.DBInstanceClass)
+	d.Set("db_instance_storage", instance.DBInstanceStorage)
+	d.Set("storage_engine", instance.StorageEngine)
+	d.Set("instance_charge_type", instance.ChargeType)
+	d.Set("zone_id", instance.ZoneId)
+	d.Set("vswitch_id", instance.VSwitchId)
+	if factor, err := strconv.Atoi(instance.ReplicationFactor); err == nil {
+		d.Set("replication_factor", factor)
+	}
+
+	return readMongoDBInstanceAttributes(d, meta)
+}
+
+func resourceAlicloudMongoDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	mongoDBService := MongoDBService{client}
+	d.Partial(true)
+
+	if err := updateMongoDBInstanceAttributes(d, meta); err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		d.Partial(false)
+		return resourceAlicloudMongoDBInstanceRead(d, meta)
+	}
+
+	if d.HasChange("db_instance_class") || d.HasChange("db_instance_storage") {
+		// wait instance status is Running before modifying
+		if err := mongoDBService.WaitForMongoDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		}
+		request := dds.CreateModifyDBInstanceSpecRequest()
+		request.DBInstanceId = d.Id()
+		request.DBInstanceClass = d.Get("db_instance_class").(string)
+		request.DBInstanceStorage = strconv.Itoa(d.Get("db_instance_storage").(int))
+		request.EffectiveTime = "Immediately"
+		_, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+			return ddsClient.ModifyDBInstanceSpec(request)
+		})
+		if err != nil {
+			return fmt.Errorf("ModifyDBInstanceSpec got an error: %#v", err)
+		}
+		// wait instance status is Running after modifying
+		if err := mongoDBService.WaitForMongoDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		}
+		// There needs more time to sync instance spec update
+		if err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+			instance, err := mongoDBService.DescribeMongoDBInstance(d.Id())
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if instance.DBInstanceClass != request.DBInstanceClass || strconv.Itoa(instance.DBInstanceStorage) != request.DBInstanceStorage {
+				return resource.RetryableError(fmt.Errorf("Waitting for instance spec is changed timeout. "+
+					"Expect instance class %s and storage %s, got %s and %d.",
+					request.DBInstanceClass, request.DBInstanceStorage, instance.DBInstanceClass, instance.DBInstanceStorage))
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		d.SetPartial("db_instance_class")
+		d.SetPartial("db_instance_storage")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudMongoDBInstanceRead(d, meta)
+}
+
+func resourceAlicloudMongoDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteMongoDBInstance(d, meta)
+}
+
+func buildMongoDBCreateRequest(d *schema.ResourceData, meta interface{}) (*dds.CreateDBInstanceRequest, error) {
+	client := meta.(*connectivity.AliyunClient)
+
+	request := dds.CreateCreateDBInstanceRequest()
+	request.RegionId = client.RegionId
+	request.Engine = "MongoDB"
+	request.EngineVersion = Trim(d.Get("engine_version").(string))
+	request.DBInstanceClass = Trim(d.Get("db_instance_class").(string))
+	request.DBInstanceStorage = requests.NewInteger(d.Get("db_instance_storage").(int))
+	request.DBInstanceDescription = d.Get("name").(string)
+	request.AccountPassword = d.Get("account_password").(string)
+	if v, ok := d.GetOk("replication_factor"); ok {
+		request.ReplicationFactor = strconv.Itoa(v.(int))
+	}
+	if v, ok := d.GetOk("storage_engine"); ok {
+		request.StorageEngine = v.(string)
+	}
+	if v, ok := d.GetOk("security_ip_list"); ok {
+		request.SecurityIPList = strings.Join(expandStringList(v.(*schema.Set).List()), COMMA_SEPARATED)
+	}
+
+	request.ChargeType = d.Get("instance_charge_type").(string)
+	if PayType(request.ChargeType) == PrePaid {
+		request.Period = requests.NewInteger(d.Get("period").(int))
+	}
+
+	zoneId, vpcId, vswitchId, err := buildMongoDBNetwork(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	request.ZoneId = zoneId
+	request.NetworkType = strings.ToUpper(string(Classic))
+	if vswitchId != "" {
+		request.NetworkType = strings.ToUpper(string(Vpc))
+		request.VpcId = vpcId
+		request.VSwitchId = vswitchId
+	}
+
+	request.ClientToken = buildClientToken("TF-CreateMongoDBInstance")
+
+	return request, nil
+}
+
+// buildMongoDBNetwork returns the zone, VPC and VSwitch to create a MongoDB instance in.
+func buildMongoDBNetwork(d *schema.ResourceData, meta interface{}) (zoneId, vpcId, vswitchId string, err error) {
+	client := meta.(*connectivity.AliyunClient)
+	vpcService := VpcService{client}
+
+	zoneId = Trim(d.Get("zone_id").(string))
+	vswitchId = Trim(d.Get("vswitch_id").(string))
+	if vswitchId == "" {
+		return
+	}
+
+	// check vswitchId in zone
+	vsw, err := vpcService.DescribeVswitch(vswitchId)
+	if err != nil {
+		return "", "", "", fmt.Errorf("DescribeVSwitch got an error: %#v", err)
+	}
+
+	if zoneId == "" {
+		zoneId = vsw.ZoneId
+	} else if zoneId != vsw.ZoneId {
+		return "", "", "", fmt.Errorf("The specified vswitch %s isn't in the zone %s", vsw.VSwitchId, zoneId)
+	}
+
+	return zoneId, vsw.VpcId, vswitchId, nil
+}
+
+// updateMongoDBInstanceAttributes applies the changes of the arguments shared by the MongoDB replica set and sharding instances.
+func updateMongoDBInstanceAttributes(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	mongoDBService := MongoDBService{client}
+	timeout := int(d.Timeout(schema.TimeoutUpdate).Seconds())
+
+	if d.HasChange("backup_period") || d.HasChange("backup_time") {
+		period := expandStringList(d.Get("backup_period").(*schema.Set).List())
+		backupTime := d.Get("backup_time").(string)
+		if len(period) > 0 || backupTime != "" {
+			if len(period) == 0 || backupTime == "" {
+				policy, err := mongoDBService.DescribeMongoDBBackupPolicy(d.Id())
+				if err != nil {
+					return fmt.Errorf("DescribeBackupPolicy got an error: %#v", err)
+				}
+				if len(period) == 0 {
+					period = strings.Split(policy.PreferredBackupPeriod, COMMA_SEPARATED)
+				}
+				if backupTime == "" {
+					backupTime = policy.PreferredBackupTime
+				}
+			}
+			if err := mongoDBService.ModifyMongoDBBackupPolicy(d.Id(), period, backupTime); err != nil {
+				return fmt.Errorf("ModifyBackupPolicy got an error: %#v", err)
+			}
+		}
+		d.SetPartial("backup_period")
+		d.SetPartial("backup_time")
+	}
+
+	// The following arguments are set when creating the instance.
+	if d.IsNewResource() {
+		return nil
+	}
+
+	if d.HasChange("name") {
+		request := dds.CreateModifyDBInstanceDescriptionRequest()
+		request.DBInstanceId = d.Id()
+		request.DBInstanceDescription = d.Get("name").(string)
+		_, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+			return ddsClient.ModifyDBInstanceDescription(request)
+		})
+		if err != nil {
+			return fmt.Errorf("ModifyDBInstanceDescription got an error: %#v", err)
+		}
+		d.SetPartial("name")
+	}
+
+	if d.HasChange("security_ip_list") {
+		ips := expandStringList(d.Get("security_ip_list").(*schema.Set).List())
+		if len(ips) < 1 {
+			return fmt.Errorf("Security ips cannot be empty")
+		}
+		// wait instance status is Running before modifying
+		if err := mongoDBService.WaitForMongoDBInstance(d.Id(), Running, timeout); err != nil {
+			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		}
+		if err := mongoDBService.ModifyMongoDBSecurityIps(d.Id(), ips); err != nil {
+			return fmt.Errorf("ModifySecurityIps got an error: %#v", err)
+		}
+		d.SetPartial("security_ip_list")
+	}
+
+	if d.HasChange("account_password") {
+		if err := mongoDBService.ResetMongoDBAccountPassword(d.Id(), d.Get("account_password").(string)); err != nil {
+			return fmt.Errorf("ResetAccountPassword got an error: %#v", err)
+		}
+		d.SetPartial("account_password")
+	}
+
+	if d.HasChange("instance_charge_type") || d.HasChange("period") {
+		// for now we just support charge change from PostPaid to PrePaid
+		if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+			request := dds.CreateTransformToPrePaidRequest()
+			request.InstanceId = d.Id()
+			request.Period = requests.NewInteger(d.Get("period").(int))
+			request.AutoPay = requests.NewBoolean(true)
+			_, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+				return ddsClient.TransformToPrePaid(request)
+			})
+			if err != nil {
+				return fmt.Errorf("TransformToPrePaid got an error: %#v", err)
+			}
+			// wait instance status is Running after modifying
+			if err := mongoDBService.WaitForMongoDBInstance(d.Id(), Running, timeout); err != nil {
+				return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+			}
+			d.SetPartial("instance_charge_type")
+			d.SetPartial("period")
+		}
+	}
+
+	return nil
+}
+
+// readMongoDBInstanceAttributes reads the whitelist and the backup policy of a MongoDB replica set or sharding instance.
+func readMongoDBInstanceAttributes(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	mongoDBService := MongoDBService{client}
+
+	ips, err := mongoDBService.DescribeMongoDBSecurityIps(d.Id())
+	if err != nil {
+		return fmt.Errorf("DescribeSecurityIps got an error: %#v", err)
+	}
+	d.Set("security_ip_list", ips)
+
+	policy, err := mongoDBService.DescribeMongoDBBackupPolicy(d.Id())
+	if err != nil {
+		return fmt.Errorf("DescribeBackupPolicy got an error: %#v", err)
+	}
+	d.Set("backup_period", strings.Split(policy.PreferredBackupPeriod, COMMA_SEPARATED))
+	d.Set("backup_time", policy.PreferredBackupTime)
+	if retention, err := strconv.Atoi(policy.BackupRetentionPeriod); err == nil {
+		d.Set("retention_period", retention)
+	}
+
+	return nil
+}
+
+func deleteMongoDBInstance(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	mongoDBService := MongoDBService{client}
+
+	instance, err := mongoDBService.DescribeMongoDBInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("Error Describe MongoDB InstanceAttribute: %#v", err)
+	}
+	if PayType(instance.ChargeType) == PrePaid {
+		return fmt.Errorf("At present, 'PrePaid' instance cannot be deleted and must wait it to be expired and release it automatically")
+	}
+
+	request := dds.CreateDeleteDBInstanceRequest()
+	request.DBInstanceId = d.Id()
+
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+			return ddsClient.DeleteDBInstance(request)
+		})
+
+		if err != nil {
+			if IsExceptedError(err, InvalidMongoDBInstanceIdNotFound) {
+				return nil
+			}
+			if IsExceptedError(err, MongoDBInvalidStatus) {
+				return resource.RetryableError(fmt.Errorf("Delete MongoDB instance timeout and got an error: %#v", err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Delete MongoDB instance got an error: %#v", err))
+		}
+
+		if _, err := mongoDBService.DescribeMongoDBInstance(d.Id()); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error Describe MongoDB InstanceAttribute: %#v", err))
+		}
+
+		time.Sleep(DefaultIntervalShort * time.Second)
+		return resource.RetryableError(fmt.Errorf("Delete MongoDB instance %s timeout.", d.Id()))
+	})
+}
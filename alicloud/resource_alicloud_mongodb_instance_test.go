@@ -0,0 +1,226 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func init() {
+	resource.AddTestSweepers("alicloud_mongodb_instance", &resource.Sweeper{
+		Name: "alicloud_mongodb_instance",
+		F:    testSweepMongoDBInstances,
+	})
+}
+
+func testSweepMongoDBInstances(region string) error {
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+
+	prefixes := []string{
+		"tf-testAcc",
+		"testAcc",
+	}
+
+	var insts []dds.DBInstance
+	req := dds.CreateDescribeDBInstancesRequest()
+	req.RegionId = client.RegionId
+	req.PageSize = requests.NewInteger(PageSizeLarge)
+	req.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+			return ddsClient.DescribeDBInstances(req)
+		})
+		if err != nil {
+			return fmt.Errorf("Error retrieving MongoDB Instances: %s", err)
+		}
+		resp, _ := raw.(*dds.DescribeDBInstancesResponse)
+		if resp == nil || len(resp.DBInstances.DBInstance) < 1 {
+			break
+		}
+		insts = append(insts, resp.DBInstances.DBInstance...)
+
+		if len(resp.DBInstances.DBInstance) < PageSizeLarge {
+			break
+		}
+
+		if page, err := getNextpageNumber(req.PageNumber); err != nil {
+			return err
+		} else {
+			req.PageNumber = page
+		}
+	}
+
+	sweeped := false
+	for _, v := range insts {
+		name := v.DBInstanceDescription
+		id := v.DBInstanceId
+		skip := true
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+				skip = false
+				break
+			}
+		}
+		if skip {
+			log.Printf("[INFO] Skipping MongoDB Instance: %s (%s)", name, id)
+			continue
+		}
+
+		sweeped = true
+		log.Printf("[INFO] Deleting MongoDB Instance: %s (%s)", name, id)
+		req := dds.CreateDeleteDBInstanceRequest()
+		req.DBInstanceId = id
+		_, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+			return ddsClient.DeleteDBInstance(req)
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to delete MongoDB Instance (%s (%s)): %s", name, id, err)
+		}
+	}
+	if sweeped {
+		// Waiting 30 seconds to eusure these MongoDB instances have been deleted.
+		time.Sleep(30 * time.Second)
+	}
+	return nil
+}
+
+func TestAccAlicloudMongoDBInstance_vpc(t *testing.T) {
+	var instance dds.DBInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_mongodb_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBInstance_vpc("tf-testAccMongoDBInstance_vpc", "dds.mongo.mid", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBInstanceExists("alicloud_mongodb_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "name", "tf-testAccMongoDBInstance_vpc"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "engine_version", "3.4"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "db_instance_class", "dds.mongo.mid"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "db_instance_storage", "10"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "replication_factor", "3"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "storage_engine", string(WiredTiger)),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "instance_charge_type", string(PostPaid)),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "security_ip_list.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "backup_period.#", "2"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "backup_time", "02:00Z-03:00Z"),
+					resource.TestCheckResourceAttrSet("alicloud_mongodb_instance.foo", "zone_id"),
+					resource.TestCheckResourceAttrSet("alicloud_mongodb_instance.foo", "vswitch_id"),
+				),
+			},
+			{
+				Config: testAccMongoDBInstance_vpc("tf-testAccMongoDBInstance_vpcUpdate", "dds.mongo.standard", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBInstanceExists("alicloud_mongodb_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "name", "tf-testAccMongoDBInstance_vpcUpdate"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "db_instance_class", "dds.mongo.standard"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_instance.foo", "db_instance_storage", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBInstanceExists(n string, d *dds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MongoDB Instance ID is set")
+		}
+
+		client := testAccProvider.Meta().(*connectivity.AliyunClient)
+		mongoDBService := MongoDBService{client}
+
+		attr, err := mongoDBService.DescribeMongoDBInstance(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = attr
+		return nil
+	}
+}
+
+func testAccCheckMongoDBInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AliyunClient)
+	mongoDBService := MongoDBService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_mongodb_instance" && rs.Type != "alicloud_mongodb_sharding_instance" {
+			continue
+		}
+
+		if _, err := mongoDBService.DescribeMongoDBInstance(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("MongoDB instance %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccMongoDBInstanceCommon = `
+data "alicloud_zones" "default" {
+	available_resource_creation = "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "${var.name}"
+	cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "foo" {
+	vpc_id = "${alicloud_vpc.foo.id}"
+	cidr_block = "172.16.0.0/24"
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+	name = "${var.name}"
+}
+`
+
+func testAccMongoDBInstance_vpc(name, class string, storage int) string {
+	return fmt.Sprintf(`
+	%s
+	variable "name" {
+		default = "tf-testAccMongoDBInstance_vpc"
+	}
+
+	resource "alicloud_mongodb_instance" "foo" {
+		name = "%s"
+		engine_version = "3.4"
+		db_instance_class = "%s"
+		db_instance_storage = %d
+		vswitch_id = "${alicloud_vswitch.foo.id}"
+		security_ip_list = ["10.168.1.12"]
+		account_password = "Test12345"
+		backup_period = ["Monday", "Wednesday"]
+		backup_time = "02:00Z-03:00Z"
+	}
+	`, testAccMongoDBInstanceCommon, name, class, storage)
+}
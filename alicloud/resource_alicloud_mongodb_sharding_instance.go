@@ -0,0 +1,408 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudMongoDBShardingInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudMongoDBShardingInstanceCreate,
+		Read:   resourceAlicloudMongoDBShardingInstanceRead,
+		Update: resourceAlicloudMongoDBShardingInstanceUpdate,
+		Delete: resourceAlicloudMongoDBShardingInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"engine_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"storage_engine": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(WiredTiger), string(RocksDB)}),
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      PostPaid,
+				ValidateFunc: validateInstanceChargeType,
+			},
+			"period": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateFunc:     validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36}),
+				DiffSuppressFunc: rkvPostPaidDiffSuppressFunc,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDBInstanceName,
+			},
+			"security_ip_list": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"account_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validateRKVPassword,
+			},
+			"backup_period": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"backup_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue(BACKUP_TIME),
+			},
+			"retention_period": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"shard_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 32,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_class": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"node_storage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntegerInRange(10, 2000),
+						},
+						"node_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"mongo_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 32,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_class": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"node_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connect_string": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudMongoDBShardingInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	mongoDBService := MongoDBService{client}
+
+	request, err := buildMongoDBShardingCreateRequest(d, meta)
+	if err != nil {
+		return err
+	}
+
+	raw, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+		return ddsClient.CreateShardingDBInstance(request)
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Alicloud MongoDB sharding instance: %#v", err)
+	}
+	resp, _ := raw.(*dds.CreateShardingDBInstanceResponse)
+	d.SetId(resp.DBInstanceId)
+
+	// wait instance status change from Creating to Running
+	if err := mongoDBService.WaitForMongoDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+	}
+
+	return resourceAlicloudMongoDBShardingInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudMongoDBShardingInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	mongoDBService := MongoDBService{client}
+
+	instance, err := mongoDBService.DescribeMongoDBInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe MongoDB InstanceAttribute: %#v", err)
+	}
+
+	d.Set("name", instance.DBInstanceDescription)
+	d.Set("engine_version", instance.EngineVersion)
+	d.Set("storage_engine", instance.StorageEngine)
+	d.Set("instance_charge_type", instance.ChargeType)
+	d.Set("zone_id", instance.ZoneId)
+	d.Set("vswitch_id", instance.VSwitchId)
+
+	var shards []map[string]interface{}
+	for _, shard := range instance.ShardList.ShardAttribute {
+		shards = append(shards, map[string]interface{}{
+			"node_class":   shard.NodeClass,
+			"node_storage": shard.NodeStorage,
+			"node_id":      shard.NodeId,
+		})
+	}
+	if err := d.Set("shard_list", shards); err != nil {
+		return err
+	}
+
+	var mongos []map[string]interface{}
+	for _, m := range instance.MongosList.MongosAttribute {
+		mongos = append(mongos, map[string]interface{}{
+			"node_class":     m.NodeClass,
+			"node_id":        m.NodeId,
+			"connect_string": m.ConnectSting,
+			"port":           m.Port,
+		})
+	}
+	if err := d.Set("mongo_list", mongos); err != nil {
+		return err
+	}
+
+	return readMongoDBInstanceAttributes(d, meta)
+}
+
+func resourceAlicloudMongoDBShardingInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(true)
+
+	if err := updateMongoDBInstanceAttributes(d, meta); err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		d.Partial(false)
+		return resourceAlicloudMongoDBShardingInstanceRead(d, meta)
+	}
+
+	if err := updateMongoDBShardingNodes(d, meta, "shard_list", MongoDBShardNode); err != nil {
+		return err
+	}
+	if err := updateMongoDBShardingNodes(d, meta, "mongo_list", MongoDBMongosNode); err != nil {
+		return err
+	}
+
+	d.Partial(false)
+	return resourceAlicloudMongoDBShardingInstanceRead(d, meta)
+}
+
+func resourceAlicloudMongoDBShardingInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteMongoDBInstance(d, meta)
+}
+
+func buildMongoDBShardingCreateRequest(d *schema.ResourceData, meta interface{}) (*dds.CreateShardingDBInstanceRequest, error) {
+	client := meta.(*connectivity.AliyunClient)
+
+	request := dds.CreateCreateShardingDBInstanceRequest()
+	request.RegionId = client.RegionId
+	request.Engine = "MongoDB"
+	request.EngineVersion = Trim(d.Get("engine_version").(string))
+	request.DBInstanceDescription = d.Get("name").(string)
+	request.AccountPassword = d.Get("account_password").(string)
+	if v, ok := d.GetOk("storage_engine"); ok {
+		request.StorageEngine = v.(string)
+	}
+	if v, ok := d.GetOk("security_ip_list"); ok {
+		request.SecurityIPList = strings.Join(expandStringList(v.(*schema.Set).List()), COMMA_SEPARATED)
+	}
+
+	var shards []dds.CreateShardingDBInstanceReplicaSet
+	for _, raw := range d.Get("shard_list").([]interface{}) {
+		shard := raw.(map[string]interface{})
+		shards = append(shards, dds.CreateShardingDBInstanceReplicaSet{
+			Class:   shard["node_class"].(string),
+			Storage: strconv.Itoa(shard["node_storage"].(int)),
+		})
+	}
+	request.ReplicaSet = &shards
+
+	var mongos []dds.CreateShardingDBInstanceMongos
+	for _, raw := range d.Get("mongo_list").([]interface{}) {
+		m := raw.(map[string]interface{})
+		mongos = append(mongos, dds.CreateShardingDBInstanceMongos{
+			Class: m["node_class"].(string),
+		})
+	}
+	request.Mongos = &mongos
+
+	// The config servers only have one specification at present.
+	request.ConfigServer = &[]dds.CreateShardingDBInstanceConfigServer{
+		{
+			Class:   "dds.cs.mid",
+			Storage: "20",
+		},
+	}
+
+	request.ChargeType = d.Get("instance_charge_type").(string)
+	if PayType(request.ChargeType) == PrePaid {
+		request.Period = requests.NewInteger(d.Get("period").(int))
+	}
+
+	zoneId, vpcId, vswitchId, err := buildMongoDBNetwork(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	request.ZoneId = zoneId
+	request.NetworkType = strings.ToUpper(string(Classic))
+	if vswitchId != "" {
+		request.NetworkType = strings.ToUpper(string(Vpc))
+		request.VpcId = vpcId
+		request.VSwitchId = vswitchId
+	}
+
+	request.ClientToken = buildClientToken("TF-CreateMongoDBShardingInstance")
+
+	return request, nil
+}
+
+// updateMongoDBShardingNodes compares the nodes of a sharding instance by their positions in the list:
+// the nodes whose specification changes are modified, the appended ones are created and the removed ones are deleted.
+func updateMongoDBShardingNodes(d *schema.ResourceData, meta interface{}, key string, nodeType MongoDBNodeType) error {
+	if !d.HasChange(key) {
+		return nil
+	}
+	client := meta.(*connectivity.AliyunClient)
+	mongoDBService := MongoDBService{client}
+	timeout := int(d.Timeout(schema.TimeoutUpdate).Seconds())
+
+	o, n := d.GetChange(key)
+	oldNodes := o.([]interface{})
+	newNodes := n.([]interface{})
+
+	for i, raw := range newNodes {
+		node := raw.(map[string]interface{})
+		var request requests.AcsRequest
+		if i < len(oldNodes) {
+			oldNode := oldNodes[i].(map[string]interface{})
+			if node["node_class"] == oldNode["node_class"] && node["node_storage"] == oldNode["node_storage"] {
+				continue
+			}
+			req := dds.CreateModifyNodeSpecRequest()
+			req.DBInstanceId = d.Id()
+			req.NodeId = oldNode["node_id"].(string)
+			req.NodeClass = node["node_class"].(string)
+			if nodeType == MongoDBShardNode {
+				req.NodeStorage = requests.NewInteger(node["node_storage"].(int))
+			}
+			req.EffectiveTime = "Immediately"
+			request = req
+		} else {
+			req := dds.CreateCreateNodeRequest()
+			req.DBInstanceId = d.Id()
+			req.NodeType = string(nodeType)
+			req.NodeClass = node["node_class"].(string)
+			if nodeType == MongoDBShardNode {
+				req.NodeStorage = requests.NewInteger(node["node_storage"].(int))
+			}
+			request = req
+		}
+		if err := modifyMongoDBShardingNode(client, request, timeout); err != nil {
+			return err
+		}
+		if err := mongoDBService.WaitForMongoDBInstance(d.Id(), Running, timeout); err != nil {
+			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		}
+	}
+
+	for i := len(oldNodes) - 1; i >= len(newNodes); i-- {
+		req := dds.CreateDeleteNodeRequest()
+		req.DBInstanceId = d.Id()
+		req.NodeId = oldNodes[i].(map[string]interface{})["node_id"].(string)
+		if err := modifyMongoDBShardingNode(client, req, timeout); err != nil {
+			return err
+		}
+		if err := mongoDBService.WaitForMongoDBInstance(d.Id(), Running, timeout); err != nil {
+			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		}
+	}
+
+	d.SetPartial(key)
+	return nil
+}
+
+// modifyMongoDBShardingNode sends a node request, retrying while the instance is still applying the former one.
+func modifyMongoDBShardingNode(client *connectivity.AliyunClient, request requests.AcsRequest, timeout int) error {
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		_, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+			switch req := request.(type) {
+			case *dds.ModifyNodeSpecRequest:
+				return ddsClient.ModifyNodeSpec(req)
+			case *dds.CreateNodeRequest:
+				return ddsClient.CreateNode(req)
+			case *dds.DeleteNodeRequest:
+				return ddsClient.DeleteNode(req)
+			}
+			return nil, fmt.Errorf("unsupported MongoDB node request %T", request)
+		})
+		if err != nil {
+			if IsExceptedError(err, MongoDBInvalidStatus) {
+				time.Sleep(DefaultIntervalShort * time.Second)
+				return resource.RetryableError(fmt.Errorf("%T timeout and got an error: %#v", request, err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("%T got an error: %#v", request, err))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,82 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudMongoDBShardingInstance_vpc(t *testing.T) {
+	var instance dds.DBInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_mongodb_sharding_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMongoDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBShardingInstance_vpc("tf-testAccMongoDBShardingInstance_vpc", 10, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBInstanceExists("alicloud_mongodb_sharding_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_mongodb_sharding_instance.foo", "name", "tf-testAccMongoDBShardingInstance_vpc"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_sharding_instance.foo", "engine_version", "3.4"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_sharding_instance.foo", "shard_list.#", "2"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_sharding_instance.foo", "shard_list.0.node_storage", "10"),
+					resource.TestCheckResourceAttrSet("alicloud_mongodb_sharding_instance.foo", "shard_list.0.node_id"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_sharding_instance.foo", "mongo_list.#", "2"),
+					resource.TestCheckResourceAttrSet("alicloud_mongodb_sharding_instance.foo", "mongo_list.0.node_id"),
+					resource.TestCheckResourceAttrSet("alicloud_mongodb_sharding_instance.foo", "mongo_list.0.connect_string"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_sharding_instance.foo", "security_ip_list.#", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBShardingInstance_vpc("tf-testAccMongoDBShardingInstance_vpc", 20, 10, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBInstanceExists("alicloud_mongodb_sharding_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_mongodb_sharding_instance.foo", "shard_list.#", "3"),
+					resource.TestCheckResourceAttr("alicloud_mongodb_sharding_instance.foo", "shard_list.0.node_storage", "20"),
+					resource.TestCheckResourceAttrSet("alicloud_mongodb_sharding_instance.foo", "shard_list.2.node_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMongoDBShardingInstance_vpc(name string, storages ...int) string {
+	var shards []string
+	for _, storage := range storages {
+		shards = append(shards, fmt.Sprintf(`{
+			node_class = "dds.shard.mid"
+			node_storage = %d
+		}`, storage))
+	}
+	return fmt.Sprintf(`
+	%s
+	variable "name" {
+		default = "tf-testAccMongoDBShardingInstance_vpc"
+	}
+
+	resource "alicloud_mongodb_sharding_instance" "foo" {
+		name = "%s"
+		engine_version = "3.4"
+		vswitch_id = "${alicloud_vswitch.foo.id}"
+		security_ip_list = ["10.168.1.12"]
+		account_password = "Test12345"
+		shard_list = [%s]
+		mongo_list = [{
+			node_class = "dds.mongos.mid"
+		}, {
+			node_class = "dds.mongos.mid"
+		}]
+	}
+	`, testAccMongoDBInstanceCommon, name, strings.Join(shards, ", "))
+}
@@ -24,6 +24,8 @@ func resourceAliyunNatGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsAll,
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -92,6 +94,10 @@ func resourceAliyunNatGateway() *schema.Resource {
 				MaxItems: 4,
 				Optional: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -145,6 +151,10 @@ func resourceAliyunNatGatewayCreate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	if err := setTags(client, &VpcService{client}, TagResourceNatGateway, d); err != nil {
+		return err
+	}
+
 	return resourceAliyunNatGatewayRead(d, meta)
 }
 
@@ -177,6 +187,10 @@ func resourceAliyunNatGatewayRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("bandwidth_packages", bindWidthPackages)
 	}
 
+	if err := readTags(client, &vpcService, TagResourceNatGateway, d); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -191,6 +205,13 @@ func resourceAliyunNatGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.Partial(true)
+
+	if err := setTags(client, &vpcService, TagResourceNatGateway, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
 	attributeUpdate := false
 	args := vpc.CreateModifyNatGatewayAttributeRequest()
 	args.RegionId = natGateway.RegionId
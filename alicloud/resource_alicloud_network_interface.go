@@ -23,6 +23,8 @@ func resourceAliyunNetworkInterface() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsAll,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -67,7 +69,8 @@ func resourceAliyunNetworkInterface() *schema.Resource {
 				Optional: true,
 				Default:  "",
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -142,9 +145,7 @@ func resourceAliyunNetworkInterfaceRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("DescribeTags of NetworkInterface(%s) failed, %#v", d.Id(), err)
 	}
 
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
-	}
+	setTagsAll(client, d, tagsToMap(tags))
 
 	return nil
 }
@@ -306,10 +307,11 @@ func resourceAliyunNetworkInterfaceUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if err := setTags(client, TagResourceEni, d); err != nil {
+	if err := setTags(client, &ecsService, TagResourceEni, d); err != nil {
 		return fmt.Errorf("SetTags of NetworkInterface(%s) failed, %#v", d.Id(), err)
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
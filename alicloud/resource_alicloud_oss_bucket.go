@@ -23,6 +23,8 @@ func resourceAlicloudOssBucket() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsAll,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:         schema.TypeString,
@@ -179,6 +181,119 @@ func resourceAlicloudOssBucket() *schema.Resource {
 				MaxItems: 1000,
 			},
 
+			"versioning": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validateAllowedStringValue([]string{
+								string(VersioningEnabled),
+								string(VersioningSuspended),
+							}),
+						},
+					},
+				},
+			},
+
+			"server_side_encryption_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sse_algorithm": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validateAllowedStringValue([]string{
+								string(SSEAlgorithmAES256),
+								string(SSEAlgorithmKMS),
+							}),
+						},
+						"kms_master_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"replication_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefixes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(ReplicationActionAll),
+							ValidateFunc: validateAllowedStringValue([]string{
+								string(ReplicationActionAll),
+								string(ReplicationActionPut),
+							}),
+						},
+						"target_bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"target_location": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"historical_object_replication": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(HistoricalObjectReplicationEnabled),
+							ValidateFunc: validateAllowedStringValue([]string{
+								string(HistoricalObjectReplicationEnabled),
+								string(HistoricalObjectReplicationDisabled),
+							}),
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateJsonString,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := compareJsonTemplateAreEquivalent(old, new)
+					return equal
+				},
+			},
+
+			"transfer_acceleration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"creation_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -203,6 +318,10 @@ func resourceAlicloudOssBucket() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -380,11 +499,10 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 		return ossClient.GetBucketLifecycle(d.Id())
 	})
 	if err != nil {
-		if ossNotFoundError(err) {
-			log.Printf("[WARN] OSS bucket: %s, no lifecycle could be found.", d.Id())
-			return nil
+		if !ossNotFoundError(err) {
+			return fmt.Errorf("Error getting bucket lifecycle: %#v", err)
 		}
-		return fmt.Errorf("Error getting bucket lifecycle: %#v", err)
+		log.Printf("[WARN] OSS bucket: %s, no lifecycle could be found.", d.Id())
 	}
 	lifecycle, _ := raw.(oss.GetBucketLifecycleResult)
 	if len(lifecycle.Rules) > 0 {
@@ -401,10 +519,14 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 			}
 
 			// expiration
-			if &lifecycleRule.Expiration != nil {
+			if lifecycleRule.Expiration != nil {
 				e := make(map[string]interface{})
-				if !lifecycleRule.Expiration.Date.IsZero() {
-					e["date"] = (lifecycleRule.Expiration.Date).Format("2006-01-02")
+				if lifecycleRule.Expiration.Date != "" {
+					t, err := time.Parse(time.RFC3339, lifecycleRule.Expiration.Date)
+					if err != nil {
+						return fmt.Errorf("Error Parsing Alicloud OSS Bucket Lifecycle Expiration Date: %s", err.Error())
+					}
+					e["date"] = t.Format("2006-01-02")
 				}
 				if &lifecycleRule.Expiration.Days != nil {
 					e["days"] = int(lifecycleRule.Expiration.Days)
@@ -419,6 +541,14 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if err := resourceAlicloudOssBucketSecurityRead(client, d); err != nil {
+		return err
+	}
+
+	if err := readTags(client, &OssService{client}, TagResourceBucket, d); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -472,6 +602,47 @@ func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("lifecycle_rule")
 	}
 
+	if d.HasChange("versioning") {
+		if err := resourceAlicloudOssBucketVersioningUpdate(client, d); err != nil {
+			return err
+		}
+		d.SetPartial("versioning")
+	}
+
+	if d.HasChange("server_side_encryption_rule") {
+		if err := resourceAlicloudOssBucketEncryptionUpdate(client, d); err != nil {
+			return err
+		}
+		d.SetPartial("server_side_encryption_rule")
+	}
+
+	if d.HasChange("replication_rule") {
+		if err := resourceAlicloudOssBucketReplicationUpdate(client, d); err != nil {
+			return err
+		}
+		d.SetPartial("replication_rule")
+	}
+
+	if d.HasChange("policy") {
+		if err := resourceAlicloudOssBucketPolicyUpdate(client, d); err != nil {
+			return err
+		}
+		d.SetPartial("policy")
+	}
+
+	if d.HasChange("transfer_acceleration") {
+		if err := resourceAlicloudOssBucketTransferAccelerationUpdate(client, d); err != nil {
+			return err
+		}
+		d.SetPartial("transfer_acceleration")
+	}
+
+	if err := setTags(client, &OssService{client}, TagResourceBucket, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
 	d.Partial(false)
 	return resourceAlicloudOssBucketRead(d, meta)
 }
@@ -696,12 +867,12 @@ func resourceAlicloudOssBucketLifecycleRuleUpdate(client *connectivity.AliyunCli
 				if err != nil {
 					return fmt.Errorf("Error Parsing Alicloud OSS Bucket Lifecycle Expiration Date: %s", err.Error())
 				}
-				i.Date = time.Time(t)
+				i.Date = t.Format("2006-01-02T15:04:05.000Z")
 			}
 			if valDays > 0 {
 				i.Days = valDays
 			}
-			rule.Expiration = i
+			rule.Expiration = &i
 		}
 		rules = append(rules, rule)
 	}
@@ -721,6 +892,182 @@ func resourceAlicloudOssBucketLifecycleRuleUpdate(client *connectivity.AliyunCli
 
 	return nil
 }
+
+// resourceAlicloudOssBucketSecurityRead reads the versioning, encryption, replication, policy and transfer acceleration
+// configurations of the bucket.
+func resourceAlicloudOssBucketSecurityRead(client *connectivity.AliyunClient, d *schema.ResourceData) error {
+	ossService := OssService{client}
+
+	status, err := ossService.DescribeBucketVersioning(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error getting bucket versioning: %#v", err)
+	}
+	versioning := make([]map[string]interface{}, 0)
+	if status != "" {
+		versioning = append(versioning, map[string]interface{}{"status": status})
+	}
+	if err := d.Set("versioning", versioning); err != nil {
+		return err
+	}
+
+	encryption, err := ossService.DescribeBucketEncryption(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error getting bucket encryption: %#v", err)
+	}
+	encryptionRules := make([]map[string]interface{}, 0)
+	if encryption != nil {
+		encryptionRules = append(encryptionRules, map[string]interface{}{
+			"sse_algorithm":     encryption.SSEAlgorithm,
+			"kms_master_key_id": encryption.KMSMasterKeyID,
+		})
+	}
+	if err := d.Set("server_side_encryption_rule", encryptionRules); err != nil {
+		return err
+	}
+
+	replication, err := ossService.DescribeBucketReplication(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error getting bucket replication: %#v", err)
+	}
+	replicationRules := make([]map[string]interface{}, 0, len(replication))
+	for _, r := range replication {
+		replicationRules = append(replicationRules, map[string]interface{}{
+			"id":                            r.ID,
+			"prefixes":                      r.Prefixes,
+			"action":                        r.Action,
+			"target_bucket":                 r.TargetBucket,
+			"target_location":               r.TargetLocation,
+			"historical_object_replication": r.HistoricalObjectReplication,
+			"status":                        r.Status,
+		})
+	}
+	if err := d.Set("replication_rule", replicationRules); err != nil {
+		return err
+	}
+
+	policy, err := ossService.DescribeBucketPolicy(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error getting bucket policy: %#v", err)
+	}
+	d.Set("policy", policy)
+
+	enabled, err := ossService.DescribeBucketTransferAcceleration(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error getting bucket transfer acceleration: %#v", err)
+	}
+	// the disabled acceleration is the same as the one never set unless it is configured explicitly
+	acceleration := make([]map[string]interface{}, 0)
+	if enabled || len(d.Get("transfer_acceleration").([]interface{})) > 0 {
+		acceleration = append(acceleration, map[string]interface{}{"enabled": enabled})
+	}
+	if err := d.Set("transfer_acceleration", acceleration); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAlicloudOssBucketVersioningUpdate(client *connectivity.AliyunClient, d *schema.ResourceData) error {
+	ossService := OssService{client}
+	versioning := d.Get("versioning").([]interface{})
+	if len(versioning) == 0 {
+		if o, _ := d.GetChange("versioning"); len(o.([]interface{})) > 0 {
+			return fmt.Errorf("The versioning of OSS bucket %s can not be disabled once it is enabled. Please set its status to %s instead.", d.Id(), VersioningSuspended)
+		}
+		return nil
+	}
+	status := versioning[0].(map[string]interface{})["status"].(string)
+	if err := ossService.PutBucketVersioning(d.Id(), VersioningStatus(status)); err != nil {
+		return fmt.Errorf("Error putting OSS bucket versioning: %#v", err)
+	}
+	return nil
+}
+
+func resourceAlicloudOssBucketEncryptionUpdate(client *connectivity.AliyunClient, d *schema.ResourceData) error {
+	ossService := OssService{client}
+	rules := d.Get("server_side_encryption_rule").([]interface{})
+	if len(rules) == 0 {
+		if err := ossService.DeleteBucketEncryption(d.Id()); err != nil && !IsExceptedError(err, NoSuchServerSideEncryptionRule) {
+			return fmt.Errorf("Error removing OSS bucket encryption: %#v", err)
+		}
+		return nil
+	}
+	rule := rules[0].(map[string]interface{})
+	algorithm := SSEAlgorithm(rule["sse_algorithm"].(string))
+	keyId := rule["kms_master_key_id"].(string)
+	if keyId != "" && algorithm != SSEAlgorithmKMS {
+		return fmt.Errorf("'kms_master_key_id' can only be set when 'sse_algorithm' is %s.", SSEAlgorithmKMS)
+	}
+	if err := ossService.PutBucketEncryption(d.Id(), algorithm, keyId); err != nil {
+		return fmt.Errorf("Error putting OSS bucket encryption: %#v", err)
+	}
+	return nil
+}
+
+// resourceAlicloudOssBucketReplicationUpdate replaces the replication rule, since a rule can not be modified.
+func resourceAlicloudOssBucketReplicationUpdate(client *connectivity.AliyunClient, d *schema.ResourceData) error {
+	ossService := OssService{client}
+	o, n := d.GetChange("replication_rule")
+	for _, r := range o.([]interface{}) {
+		if id := r.(map[string]interface{})["id"].(string); id != "" {
+			if err := ossService.DeleteBucketReplication(d.Id(), id); err != nil {
+				return fmt.Errorf("Error removing OSS bucket replication rule %s: %#v", id, err)
+			}
+		}
+	}
+	for _, r := range n.([]interface{}) {
+		rule := r.(map[string]interface{})
+		replication := ossBucketReplicationRule{
+			Prefixes:                    expandStringList(rule["prefixes"].([]interface{})),
+			Action:                      rule["action"].(string),
+			TargetBucket:                rule["target_bucket"].(string),
+			TargetLocation:              rule["target_location"].(string),
+			HistoricalObjectReplication: rule["historical_object_replication"].(string),
+		}
+		// the deleted rule keeps closing for a while and a new rule can not be added until it is closed
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			if err := ossService.PutBucketReplication(d.Id(), replication); err != nil {
+				if IsExceptedError(err, "BucketReplicationAlreadyExist") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error putting OSS bucket replication rule: %#v", err)
+		}
+	}
+	return nil
+}
+
+func resourceAlicloudOssBucketPolicyUpdate(client *connectivity.AliyunClient, d *schema.ResourceData) error {
+	ossService := OssService{client}
+	policy := d.Get("policy").(string)
+	if policy == "" {
+		if err := ossService.DeleteBucketPolicy(d.Id()); err != nil && !IsExceptedError(err, NoSuchBucketPolicy) {
+			return fmt.Errorf("Error removing OSS bucket policy: %#v", err)
+		}
+		return nil
+	}
+	if err := ossService.PutBucketPolicy(d.Id(), policy); err != nil {
+		return fmt.Errorf("Error putting OSS bucket policy: %#v", err)
+	}
+	return nil
+}
+
+func resourceAlicloudOssBucketTransferAccelerationUpdate(client *connectivity.AliyunClient, d *schema.ResourceData) error {
+	ossService := OssService{client}
+	enabled := false
+	if acceleration := d.Get("transfer_acceleration").([]interface{}); len(acceleration) > 0 {
+		enabled = acceleration[0].(map[string]interface{})["enabled"].(bool)
+	}
+	if err := ossService.PutBucketTransferAcceleration(d.Id(), enabled); err != nil {
+		return fmt.Errorf("Error putting OSS bucket transfer acceleration: %#v", err)
+	}
+	return nil
+}
+
 func resourceAlicloudOssBucketDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	ossService := OssService{client}
@@ -3,7 +3,6 @@ package alicloud
 import (
 	"bytes"
 	"fmt"
-	"io"
 	"log"
 	"strings"
 	"time"
@@ -21,6 +20,9 @@ func resourceAlicloudOssBucketObject() *schema.Resource {
 		Read:   resourceAlicloudOssBucketObjectRead,
 		Update: resourceAlicloudOssBucketObjectPut,
 		Delete: resourceAlicloudOssBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudOssBucketObjectImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -47,6 +49,11 @@ func resourceAlicloudOssBucketObject() *schema.Resource {
 				ConflictsWith: []string{"source"},
 			},
 
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"acl": {
 				Type:         schema.TypeString,
 				Default:      oss.ACLPrivate,
@@ -83,6 +90,7 @@ func resourceAlicloudOssBucketObject() *schema.Resource {
 			"content_md5": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 
 			"expires": {
@@ -107,15 +115,13 @@ func resourceAlicloudOssBucketObject() *schema.Resource {
 
 func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
-		return ossClient.Bucket(d.Get("bucket").(string))
-	})
+	ossService := OssService{client}
+	bucket, err := ossService.GetBucket(d.Get("bucket").(string))
 	if err != nil {
-		return fmt.Errorf("Error getting bucket: %#v", err)
+		return err
 	}
-	bucket, _ := raw.(*oss.Bucket)
 	var filePath string
-	var body io.Reader
+	var body []byte
 
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
@@ -126,8 +132,7 @@ func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}
 
 		filePath = path
 	} else if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
+		body = []byte(v.(string))
 	} else {
 		return fmt.Errorf("[ERROR] Must specify \"source\" or \"content\" field")
 	}
@@ -137,12 +142,26 @@ func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return err
 	}
+
+	// the configured content_md5 is used to check the integrity of the object, otherwise it is computed from the content
+	contentMD5 := ""
+	if d.HasChange("content_md5") {
+		contentMD5 = d.Get("content_md5").(string)
+	}
 	if filePath != "" {
-		err = bucket.PutObjectFromFile(key, filePath, options...)
+		if contentMD5 == "" {
+			if contentMD5, err = ossFileContentMD5(filePath); err != nil {
+				return fmt.Errorf("Error reading source (%s): %s", filePath, err)
+			}
+		}
+		err = ossService.PutObjectFromFile(bucket, key, filePath, contentMD5, options...)
 	}
 
 	if body != nil {
-		err = bucket.PutObject(key, body, options...)
+		if contentMD5 == "" {
+			contentMD5 = ossContentMD5(body)
+		}
+		err = bucket.PutObject(key, bytes.NewReader(body), append(options, oss.ContentMD5(contentMD5))...)
 	}
 
 	if err != nil {
@@ -150,6 +169,7 @@ func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}
 	}
 
 	d.SetId(key)
+	d.Set("content_md5", contentMD5)
 	return resourceAlicloudOssBucketObjectRead(d, meta)
 }
 
@@ -187,10 +207,26 @@ func resourceAlicloudOssBucketObjectRead(d *schema.ResourceData, meta interface{
 	d.Set("expires", object.Get("Expires"))
 	d.Set("server_side_encryption", object.Get("ServerSideEncryption"))
 	d.Set("etag", strings.Trim(object.Get("ETag"), `"`))
+	// the object uploaded by multipart upload has no Content-MD5
+	if contentMD5 := object.Get("Content-MD5"); contentMD5 != "" {
+		d.Set("content_md5", contentMD5)
+	}
 
 	return nil
 }
 
+// resourceAlicloudOssBucketObjectImport imports an object by the id <bucket>:<key>. The key may contain colons.
+func resourceAlicloudOssBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	split := strings.SplitN(d.Id(), COLON_SEPARATED, 2)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, fmt.Errorf("Invalid oss bucket object import id %s, expected <bucket>:<key>.", d.Id())
+	}
+	d.Set("bucket", split[0])
+	d.Set("key", split[1])
+	d.SetId(split[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAlicloudOssBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
@@ -241,10 +277,6 @@ func buildObjectHeaderOptions(d *schema.ResourceData) (options []oss.Option, err
 		options = append(options, oss.ContentEncoding(v.(string)))
 	}
 
-	if v, ok := d.GetOk("content_md5"); ok {
-		options = append(options, oss.ContentMD5(v.(string)))
-	}
-
 	if v, ok := d.GetOk("expires"); ok {
 		expires := v.(string)
 		expiresTime, err := time.Parse(time.RFC1123, expires)
@@ -125,6 +125,66 @@ func TestAccAlicloudOssBucketObject_acl(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudOssBucketObject_sourceHash(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-oss-object-test-acc-source-hash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	var obj http.Header
+	bucket := fmt.Sprintf("tf-testacc-object-hash-%d", acctest.RandInt())
+	config := fmt.Sprintf(`
+						resource "alicloud_oss_bucket" "bucket" {
+						    bucket = "%s"
+						}
+						resource "alicloud_oss_bucket_object" "hash" {
+							bucket = "${alicloud_oss_bucket.bucket.bucket}"
+							key = "test-object-hash-key"
+							source = "%s"
+							source_hash = "${md5(file("%s"))}"
+						}`, bucket, tmpFile.Name(), tmpFile.Name())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlicloudOssBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := ioutil.WriteFile(tmpFile.Name(), []byte("{anything will do }"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudOssBucketObjectExists(
+						"alicloud_oss_bucket_object.hash", bucket, obj),
+					resource.TestCheckResourceAttr(
+						"alicloud_oss_bucket_object.hash",
+						"content_md5",
+						ossContentMD5([]byte("{anything will do }"))),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := ioutil.WriteFile(tmpFile.Name(), []byte("{anything else will do }"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudOssBucketObjectExists(
+						"alicloud_oss_bucket_object.hash", bucket, obj),
+					resource.TestCheckResourceAttr(
+						"alicloud_oss_bucket_object.hash",
+						"content_md5",
+						ossContentMD5([]byte("{anything else will do }"))),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAlicloudOssBucketObjectExists(n string, bucket string, obj http.Header) resource.TestCheckFunc {
 	providers := []*schema.Provider{testAccProvider}
 	return testAccCheckOssBucketObjectExistsWithProviders(n, bucket, obj, &providers)
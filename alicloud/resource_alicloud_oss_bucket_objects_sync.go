@@ -0,0 +1,261 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mitchellh/go-homedir"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudOssBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudOssBucketObjectsSyncPut,
+		Read:   resourceAlicloudOssBucketObjectsSyncRead,
+		Update: resourceAlicloudOssBucketObjectsSyncPut,
+		Delete: resourceAlicloudOssBucketObjectsSyncDelete,
+
+		CustomizeDiff: resourceAlicloudOssBucketObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"acl": {
+				Type:         schema.TypeString,
+				Default:      oss.ACLPrivate,
+				Optional:     true,
+				ValidateFunc: validateOssBucketAcl,
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"objects": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudOssBucketObjectsSyncPut(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ossService := OssService{client}
+	bucketName := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	bucket, err := ossService.GetBucket(bucketName)
+	if err != nil {
+		return err
+	}
+
+	source := d.Get("source_dir").(string)
+	sourceDir, err := homedir.Expand(source)
+	if err != nil {
+		return fmt.Errorf("Error expanding homedir in source_dir (%s): %s", source, err)
+	}
+	files, err := buildOssObjectsSyncFiles(sourceDir, prefix)
+	if err != nil {
+		return fmt.Errorf("Error reading source_dir (%s): %s", source, err)
+	}
+
+	remote, err := ossService.ListObjects(bucket, prefix)
+	if err != nil {
+		return fmt.Errorf("Error listing objects of OSS bucket %s: %#v", bucketName, err)
+	}
+
+	options := []oss.Option{oss.ACL(oss.ACLType(d.Get("acl").(string)))}
+	if v, ok := d.GetOk("cache_control"); ok {
+		options = append(options, oss.CacheControl(v.(string)))
+	}
+
+	// objects records the content MD5 of the uploaded objects, and it is saved even if the sync fails
+	// so that only the remaining objects are uploaded next time.
+	objects := make(map[string]interface{})
+	for key, md5 := range d.Get("objects").(map[string]interface{}) {
+		objects[key] = md5
+	}
+	d.SetId(bucketName + COLON_SEPARATED + prefix)
+
+	// the headers of all of the objects have to be changed if the acl or cache_control changes
+	putAll := d.HasChange("acl") || d.HasChange("cache_control")
+	for key, filePath := range files {
+		contentMD5, err := ossFileContentMD5(filePath)
+		if err != nil {
+			d.Set("objects", objects)
+			return fmt.Errorf("Error reading source (%s): %s", filePath, err)
+		}
+		if !putAll && !ossObjectsSyncOutdated(key, contentMD5, objects, remote) {
+			continue
+		}
+		// the content type is detected by the extension of the file
+		if err := ossService.PutObjectFromFile(bucket, key, filePath, contentMD5, options...); err != nil {
+			d.Set("objects", objects)
+			return fmt.Errorf("Error putting object %s in OSS bucket %s: %#v", key, bucketName, err)
+		}
+		objects[key] = contentMD5
+	}
+
+	var removed []string
+	for key := range objects {
+		if _, ok := files[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	if err := ossService.DeleteObjects(bucket, removed); err != nil {
+		d.Set("objects", objects)
+		return fmt.Errorf("Error deleting the removed objects in OSS bucket %s: %#v", bucketName, err)
+	}
+	for _, key := range removed {
+		delete(objects, key)
+	}
+	d.Set("objects", objects)
+
+	return resourceAlicloudOssBucketObjectsSyncRead(d, meta)
+}
+
+func resourceAlicloudOssBucketObjectsSyncRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ossService := OssService{client}
+	bucket, err := ossService.GetBucket(d.Get("bucket").(string))
+	if err != nil {
+		return err
+	}
+
+	remote, err := ossService.ListObjects(bucket, d.Get("prefix").(string))
+	if err != nil {
+		if ossNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error listing objects of OSS bucket %s: %#v", d.Get("bucket").(string), err)
+	}
+
+	// the objects deleted outside are forgotten, so that they are uploaded again by the next sync
+	objects := make(map[string]interface{})
+	for key, md5 := range d.Get("objects").(map[string]interface{}) {
+		if _, ok := remote[key]; ok {
+			objects[key] = md5
+		}
+	}
+	d.Set("objects", objects)
+
+	return nil
+}
+
+func resourceAlicloudOssBucketObjectsSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ossService := OssService{client}
+	bucket, err := ossService.GetBucket(d.Get("bucket").(string))
+	if err != nil {
+		return err
+	}
+
+	var keys []string
+	for key := range d.Get("objects").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	if err := ossService.DeleteObjects(bucket, keys); err != nil && !ossNotFoundError(err) {
+		return fmt.Errorf("Error deleting objects of OSS bucket %s: %#v", d.Get("bucket").(string), err)
+	}
+	return nil
+}
+
+// resourceAlicloudOssBucketObjectsSyncCustomizeDiff plans the objects to be synced again if the files in source_dir
+// are added, changed or removed since the last sync, or their objects are modified or deleted outside.
+func resourceAlicloudOssBucketObjectsSyncCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if !d.NewValueKnown("source_dir") {
+		return d.SetNewComputed("objects")
+	}
+
+	source := d.Get("source_dir").(string)
+	sourceDir, err := homedir.Expand(source)
+	if err != nil {
+		return fmt.Errorf("Error expanding homedir in source_dir (%s): %s", source, err)
+	}
+	files, err := buildOssObjectsSyncFiles(sourceDir, d.Get("prefix").(string))
+	if err != nil {
+		return fmt.Errorf("Error reading source_dir (%s): %s", source, err)
+	}
+	objects := d.Get("objects").(map[string]interface{})
+	if len(files) != len(objects) {
+		return d.SetNewComputed("objects")
+	}
+
+	client := meta.(*connectivity.AliyunClient)
+	ossService := OssService{client}
+	bucket, err := ossService.GetBucket(d.Get("bucket").(string))
+	if err != nil {
+		return err
+	}
+	remote, err := ossService.ListObjects(bucket, d.Get("prefix").(string))
+	if err != nil {
+		return fmt.Errorf("Error listing objects of OSS bucket %s: %#v", d.Get("bucket").(string), err)
+	}
+	for key, filePath := range files {
+		contentMD5, err := ossFileContentMD5(filePath)
+		if err != nil {
+			return fmt.Errorf("Error reading source (%s): %s", filePath, err)
+		}
+		if ossObjectsSyncOutdated(key, contentMD5, objects, remote) {
+			return d.SetNewComputed("objects")
+		}
+	}
+	return nil
+}
+
+// ossObjectsSyncOutdated reports whether the file has to be uploaded, because its content is different from the one
+// of the last sync, or its object is modified or deleted outside.
+func ossObjectsSyncOutdated(key, contentMD5 string, objects map[string]interface{}, remote map[string]string) bool {
+	etag, ok := remote[key]
+	return objects[key] != contentMD5 || !ok || !ossETagMatches(etag, contentMD5)
+}
+
+// buildOssObjectsSyncFiles returns the regular files in the directory, keyed by the object keys which are
+// the prefix joined with the slash-separated relative paths of the files.
+func buildOssObjectsSyncFiles(dir, prefix string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[prefix+strings.TrimPrefix(filepath.ToSlash(rel), "/")] = path
+		return nil
+	})
+	return files, err
+}
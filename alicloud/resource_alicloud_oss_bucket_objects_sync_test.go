@@ -0,0 +1,182 @@
+package alicloud
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudOssBucketObjectsSync_basic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-oss-objects-sync-test-acc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(filepath.Join(dir, "css"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile := func(name, content string) {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bucket := fmt.Sprintf("tf-testacc-objects-sync-%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOssBucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html></html>")
+					writeFile(filepath.Join("css", "site.css"), "body {}")
+				},
+				Config: testAccOssBucketObjectsSyncConfig(bucket, dir, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOssBucketObjectsSyncObject(bucket, "site/index.html", "text/html"),
+					testAccCheckOssBucketObjectsSyncObject(bucket, "site/css/site.css", "text/css"),
+					resource.TestCheckResourceAttr("alicloud_oss_bucket_objects_sync.foo", "objects.%", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html><body></body></html>")
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				// the changes of the files are detected without changing source_hash
+				Config: testAccOssBucketObjectsSyncConfig(bucket, dir, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOssBucketObjectsSyncObject(bucket, "site/index.html", "text/html"),
+					resource.TestCheckResourceAttr("alicloud_oss_bucket_objects_sync.foo", "objects.%", "1"),
+					resource.TestCheckResourceAttr("alicloud_oss_bucket_objects_sync.foo",
+						"objects.site/index.html", ossContentMD5([]byte("<html><body></body></html>"))),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildOssObjectsSyncFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-oss-objects-sync-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(filepath.Join(dir, "a", "b"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"index.html", filepath.Join("a", "b", "c.js")} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := buildOssObjectsSyncFiles(dir, "site/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"site/index.html": filepath.Join(dir, "index.html"),
+		"site/a/b/c.js":   filepath.Join(dir, "a", "b", "c.js"),
+	}
+	if !reflect.DeepEqual(files, expected) {
+		t.Fatalf("Expected files %#v, got %#v", expected, files)
+	}
+}
+
+func TestOssObjectsSyncOutdated(t *testing.T) {
+	contentMD5 := ossContentMD5([]byte("<html></html>"))
+	etag := "\"" + strings.ToUpper(fmt.Sprintf("%x", md5.Sum([]byte("<html></html>")))) + "\""
+	objects := map[string]interface{}{"site/index.html": contentMD5}
+
+	cases := []struct {
+		name     string
+		md5      string
+		remote   map[string]string
+		expected bool
+	}{
+		{"unchanged", contentMD5, map[string]string{"site/index.html": etag}, false},
+		{"multipart uploaded", contentMD5, map[string]string{"site/index.html": "\"5B3C1E2F-3\""}, false},
+		{"changed locally", ossContentMD5([]byte("<html><body></body></html>")), map[string]string{"site/index.html": etag}, true},
+		{"modified outside", contentMD5, map[string]string{"site/index.html": "\"D41D8CD98F00B204E9800998ECF8427E\""}, true},
+		{"deleted outside", contentMD5, map[string]string{}, true},
+	}
+	for _, c := range cases {
+		if outdated := ossObjectsSyncOutdated("site/index.html", c.md5, objects, c.remote); outdated != c.expected {
+			t.Fatalf("%s: expected outdated %t, got %t", c.name, c.expected, outdated)
+		}
+	}
+}
+
+func testAccCheckOssBucketObjectsSyncObject(bucketName, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*connectivity.AliyunClient)
+		bucket, err := (&OssService{client}).GetBucket(bucketName)
+		if err != nil {
+			return err
+		}
+		meta, err := bucket.GetObjectDetailedMeta(key)
+		if err != nil {
+			return fmt.Errorf("Error getting object %s: %#v", key, err)
+		}
+		if meta.Get(oss.HTTPHeaderContentType) != contentType {
+			return fmt.Errorf("Expected content type of object %s is %s, got %s", key, contentType, meta.Get(oss.HTTPHeaderContentType))
+		}
+		return nil
+	}
+}
+
+func testAccCheckOssBucketObjectsSyncDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AliyunClient)
+	ossService := OssService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_oss_bucket_objects_sync" {
+			continue
+		}
+		bucket, err := ossService.GetBucket(rs.Primary.Attributes["bucket"])
+		if err != nil {
+			return err
+		}
+		objects, err := ossService.ListObjects(bucket, rs.Primary.Attributes["prefix"])
+		if err != nil {
+			if ossNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if len(objects) > 0 {
+			return fmt.Errorf("Objects still exist in OSS bucket %s: %v", rs.Primary.Attributes["bucket"], objects)
+		}
+	}
+	return nil
+}
+
+func testAccOssBucketObjectsSyncConfig(bucket, dir, hash string) string {
+	return fmt.Sprintf(`
+resource "alicloud_oss_bucket" "foo" {
+	bucket = "%s"
+}
+
+resource "alicloud_oss_bucket_objects_sync" "foo" {
+	bucket = "${alicloud_oss_bucket.foo.bucket}"
+	prefix = "site/"
+	source_dir = "%s"
+	source_hash = "%s"
+}
+`, bucket, dir, hash)
+}
@@ -274,6 +274,132 @@ func TestAccAlicloudOssBucketLifecycle(t *testing.T) {
 		},
 	})
 }
+func TestAccAlicloudOssBucketVersioning(t *testing.T) {
+	var bucket oss.BucketInfo
+	randInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_oss_bucket.versioning",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckOssBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlicloudOssBucketVersioningConfig(randInt, string(VersioningEnabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOssBucketExists(
+						"alicloud_oss_bucket.versioning", &bucket),
+					resource.TestCheckResourceAttr(
+						"alicloud_oss_bucket.versioning",
+						"versioning.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_oss_bucket.versioning",
+						"versioning.0.status",
+						string(VersioningEnabled)),
+				),
+			},
+			{
+				Config: testAccAlicloudOssBucketVersioningConfig(randInt, string(VersioningSuspended)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOssBucketExists(
+						"alicloud_oss_bucket.versioning", &bucket),
+					resource.TestCheckResourceAttr(
+						"alicloud_oss_bucket.versioning",
+						"versioning.0.status",
+						string(VersioningSuspended)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudOssBucketEncryption(t *testing.T) {
+	var bucket oss.BucketInfo
+	randInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_oss_bucket.encryption",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckOssBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlicloudOssBucketEncryptionConfig(randInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOssBucketExists(
+						"alicloud_oss_bucket.encryption", &bucket),
+					resource.TestCheckResourceAttr(
+						"alicloud_oss_bucket.encryption",
+						"server_side_encryption_rule.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_oss_bucket.encryption",
+						"server_side_encryption_rule.0.sse_algorithm",
+						string(SSEAlgorithmAES256)),
+				),
+			},
+			{
+				Config: testAccAlicloudOssBucketEncryptionKMSConfig(randInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOssBucketExists(
+						"alicloud_oss_bucket.encryption", &bucket),
+					resource.TestCheckResourceAttr(
+						"alicloud_oss_bucket.encryption",
+						"server_side_encryption_rule.0.sse_algorithm",
+						string(SSEAlgorithmKMS)),
+					resource.TestCheckResourceAttrPair(
+						"alicloud_oss_bucket.encryption", "server_side_encryption_rule.0.kms_master_key_id",
+						"alicloud_kms_key.key", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudOssBucketPolicy(t *testing.T) {
+	var bucket oss.BucketInfo
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_oss_bucket.policy",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckOssBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlicloudOssBucketPolicyConfig(acctest.RandInt()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOssBucketExists(
+						"alicloud_oss_bucket.policy", &bucket),
+					resource.TestCheckResourceAttrSet(
+						"alicloud_oss_bucket.policy",
+						"policy"),
+					resource.TestCheckResourceAttr(
+						"alicloud_oss_bucket.policy",
+						"transfer_acceleration.#",
+						"1"),
+					resource.TestCheckResourceAttr(
+						"alicloud_oss_bucket.policy",
+						"transfer_acceleration.0.enabled",
+						"true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOssBucketExists(n string, b *oss.BucketInfo) resource.TestCheckFunc {
 	providers := []*schema.Provider{testAccProvider}
 	return testAccCheckOssBucketExistsWithProviders(n, b, &providers)
@@ -463,3 +589,68 @@ resource "alicloud_oss_bucket" "lifecycle"{
 }
 `, randInt)
 }
+
+func testAccAlicloudOssBucketVersioningConfig(randInt int, status string) string {
+	return fmt.Sprintf(`
+resource "alicloud_oss_bucket" "versioning"{
+	bucket = "tf-testacc-bucket-versioning-%d"
+	versioning {
+		status = "%s"
+	}
+}
+`, randInt, status)
+}
+
+func testAccAlicloudOssBucketEncryptionConfig(randInt int) string {
+	return fmt.Sprintf(`
+resource "alicloud_oss_bucket" "encryption"{
+	bucket = "tf-testacc-bucket-encryption-%d"
+	server_side_encryption_rule {
+		sse_algorithm = "AES256"
+	}
+}
+`, randInt)
+}
+
+func testAccAlicloudOssBucketEncryptionKMSConfig(randInt int) string {
+	return fmt.Sprintf(`
+resource "alicloud_kms_key" "key" {
+	description = "tf-testacc-bucket-encryption"
+	deletion_window_in_days = 7
+}
+
+resource "alicloud_oss_bucket" "encryption"{
+	bucket = "tf-testacc-bucket-encryption-%d"
+	server_side_encryption_rule {
+		sse_algorithm = "KMS"
+		kms_master_key_id = "${alicloud_kms_key.key.id}"
+	}
+}
+`, randInt)
+}
+
+func testAccAlicloudOssBucketPolicyConfig(randInt int) string {
+	return fmt.Sprintf(`
+data "alicloud_account" "current" {}
+
+resource "alicloud_oss_bucket" "policy"{
+	bucket = "tf-testacc-bucket-policy-%d"
+	policy = <<POLICY
+  {
+    "Version": "1",
+    "Statement": [
+      {
+        "Effect": "Allow",
+        "Action": ["oss:GetObject"],
+        "Principal": ["${data.alicloud_account.current.id}"],
+        "Resource": ["acs:oss:*:*:tf-testacc-bucket-policy-%d/*"]
+      }
+    ]
+  }
+  POLICY
+	transfer_acceleration {
+		enabled = true
+	}
+}
+`, randInt, randInt)
+}
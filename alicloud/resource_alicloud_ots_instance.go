@@ -20,6 +20,8 @@ func resourceAlicloudOtsInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsAll,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -54,7 +56,8 @@ func resourceAlicloudOtsInstance() *schema.Resource {
 					return d.Id() != ""
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -114,7 +117,7 @@ func resourceAliyunOtsInstanceRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("accessed_by", convertInstanceAccessedByRevert(inst.Network))
 	d.Set("instance_type", convertInstanceTypeRevert(inst.ClusterType))
 	d.Set("description", inst.Description)
-	d.Set("tags", otsTagsToMap(inst.TagInfos.TagInfo))
+	setTagsAll(client, d, otsTagsToMap(inst.TagInfos.TagInfo))
 	return nil
 }
 
@@ -137,51 +140,12 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("accessed_by")
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		if len(remove) > 0 {
-			args := ots.CreateDeleteTagsRequest()
-			args.InstanceName = d.Id()
-			var tags []ots.DeleteTagsTagInfo
-			for _, t := range remove {
-				tags = append(tags, ots.DeleteTagsTagInfo{
-					TagKey:   t.Key,
-					TagValue: t.Value,
-				})
-			}
-			args.TagInfo = &tags
-			_, err := client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
-				return otsClient.DeleteTags(args)
-			})
-			if err != nil {
-				return fmt.Errorf("Remove tags got error: %s", err)
-			}
-		}
-
-		if len(create) > 0 {
-			args := ots.CreateInsertTagsRequest()
-			args.InstanceName = d.Id()
-			var tags []ots.InsertTagsTagInfo
-			for _, t := range create {
-				tags = append(tags, ots.InsertTagsTagInfo{
-					TagKey:   t.Key,
-					TagValue: t.Value,
-				})
-			}
-			args.TagInfo = &tags
-			_, err := client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
-				return otsClient.InsertTags(args)
-			})
-			if err != nil {
-				return fmt.Errorf("Insertting tags got error: %s", err)
-			}
-		}
-		d.SetPartial("tags")
+	if err := setTags(client, &otsService, TagResourceOtsInstance, d); err != nil {
+		return err
 	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
 	if err := otsService.WaitForOtsInstance(d.Id(), Running, DefaultTimeout); err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
@@ -15,6 +16,9 @@ func resourceAlicloudOtsInstanceAttachment() *schema.Resource {
 		Create: resourceAliyunOtsInstanceAttachmentCreate,
 		Read:   resourceAliyunOtsInstanceAttachmentRead,
 		Delete: resourceAliyunOtsInstanceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunOtsInstanceAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -78,13 +82,25 @@ func resourceAliyunOtsInstanceAttachmentRead(d *schema.ResourceData, meta interf
 		}
 		return fmt.Errorf("failed to describe instance vpc with error: %s", err)
 	}
-	// There is a bug that inst does not contain instance name and vswitch ID, so this resource does not support import function.
-	//d.Set("instance_name", inst.InstanceName)
+	// There is a bug that inst does not contain instance name and vswitch ID, so the vswitch ID is taken from the import id.
+	d.Set("instance_name", d.Id())
 	d.Set("vpc_name", inst.InstanceVpcName)
 	d.Set("vpc_id", inst.VpcId)
 	return nil
 }
 
+// resourceAliyunOtsInstanceAttachmentImport takes the vswitch id from the import id, since it can not be read from the vpc of the instance.
+func resourceAliyunOtsInstanceAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, fmt.Errorf("Invalid ots instance attachment import id %s, expected <instance_name>:<vswitch_id>.", d.Id())
+	}
+	d.Set("instance_name", split[0])
+	d.Set("vswitch_id", split[1])
+	d.SetId(split[0])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAliyunOtsInstanceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	otsService := OtsService{client}
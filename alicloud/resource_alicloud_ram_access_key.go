@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/denverdino/aliyungo/ram"
@@ -16,6 +17,9 @@ func resourceAlicloudRamAccessKey() *schema.Resource {
 		Read:   resourceAlicloudRamAccessKeyRead,
 		Update: resourceAlicloudRamAccessKeyUpdate,
 		Delete: resourceAlicloudRamAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudRamAccessKeyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user_name": {
@@ -122,6 +126,19 @@ func resourceAlicloudRamAccessKeyRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// resourceAlicloudRamAccessKeyImport imports an access key of a RAM user by the id <user_name>:<access_key_id>,
+// or an access key of the current account by the id <access_key_id>.
+func resourceAlicloudRamAccessKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(split) == 2 && split[0] != "" && split[1] != "" {
+		d.Set("user_name", split[0])
+		d.SetId(split[1])
+	} else if len(split) != 1 {
+		return nil, fmt.Errorf("Invalid ram access key import id %s, expected <user_name>:<access_key_id> or <access_key_id>.", d.Id())
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAlicloudRamAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/denverdino/aliyungo/ram"
@@ -15,6 +16,9 @@ func resourceAlicloudRamGroupPolicyAtatchment() *schema.Resource {
 		Create: resourceAlicloudRamGroupPolicyAttachmentCreate,
 		Read:   resourceAlicloudRamGroupPolicyAttachmentRead,
 		Delete: resourceAlicloudRamGroupPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_name": {
@@ -56,7 +60,7 @@ func resourceAlicloudRamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta
 	if err != nil {
 		return fmt.Errorf("AttachPolicyToGroup got an error: %#v", err)
 	}
-	d.SetId("group" + args.PolicyName + string(args.PolicyType) + args.GroupName)
+	d.SetId(fmt.Sprintf("%s%s%s%s%s", args.GroupName, COLON_SEPARATED, args.PolicyName, COLON_SEPARATED, args.PolicyType))
 
 	return resourceAlicloudRamGroupPolicyAttachmentRead(d, meta)
 }
@@ -64,8 +68,14 @@ func resourceAlicloudRamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta
 func resourceAlicloudRamGroupPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 
+	// In order to be compatible with previous Id (before 1.28.0) which format to group<policy_name><policy_type><group_name>
+	if !strings.Contains(d.Id(), COLON_SEPARATED) {
+		d.SetId(fmt.Sprintf("%s%s%s%s%s", d.Get("group_name").(string), COLON_SEPARATED, d.Get("policy_name").(string), COLON_SEPARATED, d.Get("policy_type").(string)))
+	}
+
+	split := strings.Split(d.Id(), COLON_SEPARATED)
 	args := ram.GroupQueryRequest{
-		GroupName: d.Get("group_name").(string),
+		GroupName: split[0],
 	}
 
 	raw, err := client.WithRamClient(func(ramClient ram.RamClientInterface) (interface{}, error) {
@@ -81,7 +91,7 @@ func resourceAlicloudRamGroupPolicyAttachmentRead(d *schema.ResourceData, meta i
 	response, _ := raw.(ram.PolicyListResponse)
 	if len(response.Policies.Policy) > 0 {
 		for _, v := range response.Policies.Policy {
-			if v.PolicyName == d.Get("policy_name").(string) && v.PolicyType == d.Get("policy_type").(string) {
+			if v.PolicyName == split[1] && v.PolicyType == split[2] {
 				d.Set("group_name", args.GroupName)
 				d.Set("policy_name", v.PolicyName)
 				d.Set("policy_type", v.PolicyType)
@@ -97,12 +107,18 @@ func resourceAlicloudRamGroupPolicyAttachmentRead(d *schema.ResourceData, meta i
 func resourceAlicloudRamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 
+	// In order to be compatible with previous Id (before 1.28.0) which format to group<policy_name><policy_type><group_name>
+	if !strings.Contains(d.Id(), COLON_SEPARATED) {
+		d.SetId(fmt.Sprintf("%s%s%s%s%s", d.Get("group_name").(string), COLON_SEPARATED, d.Get("policy_name").(string), COLON_SEPARATED, d.Get("policy_type").(string)))
+	}
+
+	split := strings.Split(d.Id(), COLON_SEPARATED)
 	args := ram.AttachPolicyToGroupRequest{
 		PolicyRequest: ram.PolicyRequest{
-			PolicyName: d.Get("policy_name").(string),
-			PolicyType: ram.Type(d.Get("policy_type").(string)),
+			PolicyName: split[1],
+			PolicyType: ram.Type(split[2]),
 		},
-		GroupName: d.Get("group_name").(string),
+		GroupName: split[0],
 	}
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -18,6 +18,9 @@ func resourceAlicloudRamRoleAttachment() *schema.Resource {
 		Create: resourceAlicloudInstanceRoleAttachmentCreate,
 		Read:   resourceAlicloudInstanceRoleAttachmentRead,
 		Delete: resourceAlicloudInstanceRoleAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role_name": {
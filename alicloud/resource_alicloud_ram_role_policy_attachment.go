@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/denverdino/aliyungo/ram"
@@ -16,6 +17,9 @@ func resourceAlicloudRamRolePolicyAttachment() *schema.Resource {
 		Read:   resourceAlicloudRamRolePolicyAttachmentRead,
 		//Update: resourceAlicloudRamRolePolicyAttachmentUpdate,
 		Delete: resourceAlicloudRamRolePolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role_name": {
@@ -56,7 +60,7 @@ func resourceAlicloudRamRolePolicyAttachmentCreate(d *schema.ResourceData, meta
 	if err != nil {
 		return fmt.Errorf("AttachPolicyToRole got an error: %#v", err)
 	}
-	d.SetId("role" + args.PolicyName + string(args.PolicyType) + args.RoleName)
+	d.SetId(fmt.Sprintf("%s%s%s%s%s", args.RoleName, COLON_SEPARATED, args.PolicyName, COLON_SEPARATED, args.PolicyType))
 
 	return resourceAlicloudRamRolePolicyAttachmentRead(d, meta)
 }
@@ -64,8 +68,14 @@ func resourceAlicloudRamRolePolicyAttachmentCreate(d *schema.ResourceData, meta
 func resourceAlicloudRamRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 
+	// In order to be compatible with previous Id (before 1.28.0) which format to role<policy_name><policy_type><role_name>
+	if !strings.Contains(d.Id(), COLON_SEPARATED) {
+		d.SetId(fmt.Sprintf("%s%s%s%s%s", d.Get("role_name").(string), COLON_SEPARATED, d.Get("policy_name").(string), COLON_SEPARATED, d.Get("policy_type").(string)))
+	}
+
+	split := strings.Split(d.Id(), COLON_SEPARATED)
 	args := ram.RoleQueryRequest{
-		RoleName: d.Get("role_name").(string),
+		RoleName: split[0],
 	}
 
 	raw, err := client.WithRamClient(func(ramClient ram.RamClientInterface) (interface{}, error) {
@@ -77,7 +87,7 @@ func resourceAlicloudRamRolePolicyAttachmentRead(d *schema.ResourceData, meta in
 	response, _ := raw.(ram.PolicyListResponse)
 	if len(response.Policies.Policy) > 0 {
 		for _, v := range response.Policies.Policy {
-			if v.PolicyName == d.Get("policy_name").(string) && v.PolicyType == d.Get("policy_type").(string) {
+			if v.PolicyName == split[1] && v.PolicyType == split[2] {
 				d.Set("role_name", args.RoleName)
 				d.Set("policy_name", v.PolicyName)
 				d.Set("policy_type", v.PolicyType)
@@ -93,12 +103,18 @@ func resourceAlicloudRamRolePolicyAttachmentRead(d *schema.ResourceData, meta in
 func resourceAlicloudRamRolePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 
+	// In order to be compatible with previous Id (before 1.28.0) which format to role<policy_name><policy_type><role_name>
+	if !strings.Contains(d.Id(), COLON_SEPARATED) {
+		d.SetId(fmt.Sprintf("%s%s%s%s%s", d.Get("role_name").(string), COLON_SEPARATED, d.Get("policy_name").(string), COLON_SEPARATED, d.Get("policy_type").(string)))
+	}
+
+	split := strings.Split(d.Id(), COLON_SEPARATED)
 	args := ram.AttachPolicyToRoleRequest{
 		PolicyRequest: ram.PolicyRequest{
-			PolicyName: d.Get("policy_name").(string),
-			PolicyType: ram.Type(d.Get("policy_type").(string)),
+			PolicyName: split[1],
+			PolicyType: ram.Type(split[2]),
 		},
-		RoleName: d.Get("role_name").(string),
+		RoleName: split[0],
 	}
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
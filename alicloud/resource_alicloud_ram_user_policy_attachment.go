@@ -17,6 +17,9 @@ func resourceAlicloudRamUserPolicyAtatchment() *schema.Resource {
 		Create: resourceAlicloudRamUserPolicyAttachmentCreate,
 		Read:   resourceAlicloudRamUserPolicyAttachmentRead,
 		Delete: resourceAlicloudRamUserPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user_name": {
@@ -67,10 +70,8 @@ func resourceAlicloudRamUserPolicyAttachmentRead(d *schema.ResourceData, meta in
 	client := meta.(*connectivity.AliyunClient)
 
 	// In order to be compatible with previous Id (before 1.9.6) which format to user<policuy_name><policy_type><user_name>
-	id := fmt.Sprintf("%s%s%s%s%s", d.Get("user_name").(string), COLON_SEPARATED, d.Get("policy_name").(string), COLON_SEPARATED, d.Get("policy_type").(string))
-
-	if d.Id() != id {
-		d.SetId(id)
+	if !strings.Contains(d.Id(), COLON_SEPARATED) {
+		d.SetId(fmt.Sprintf("%s%s%s%s%s", d.Get("user_name").(string), COLON_SEPARATED, d.Get("policy_name").(string), COLON_SEPARATED, d.Get("policy_type").(string)))
 	}
 
 	split := strings.Split(d.Id(), COLON_SEPARATED)
@@ -87,7 +88,7 @@ func resourceAlicloudRamUserPolicyAttachmentRead(d *schema.ResourceData, meta in
 	response, _ := raw.(ram.PolicyListResponse)
 	if len(response.Policies.Policy) > 0 {
 		for _, v := range response.Policies.Policy {
-			if v.PolicyName == d.Get("policy_name").(string) && v.PolicyType == d.Get("policy_type").(string) {
+			if v.PolicyName == split[1] && v.PolicyType == split[2] {
 				d.Set("user_name", args.UserName)
 				d.Set("policy_name", v.PolicyName)
 				d.Set("policy_type", v.PolicyType)
@@ -104,10 +105,8 @@ func resourceAlicloudRamUserPolicyAttachmentDelete(d *schema.ResourceData, meta
 	client := meta.(*connectivity.AliyunClient)
 
 	// In order to be compatible with previous Id (before 1.9.6) which format to user<policuy_name><policy_type><user_name>
-	id := fmt.Sprintf("%s%s%s%s%s", d.Get("user_name").(string), COLON_SEPARATED, d.Get("policy_name").(string), COLON_SEPARATED, d.Get("policy_type").(string))
-
-	if d.Id() != id {
-		d.SetId(id)
+	if !strings.Contains(d.Id(), COLON_SEPARATED) {
+		d.SetId(fmt.Sprintf("%s%s%s%s%s", d.Get("user_name").(string), COLON_SEPARATED, d.Get("policy_name").(string), COLON_SEPARATED, d.Get("policy_type").(string)))
 	}
 
 	split := strings.Split(d.Id(), COLON_SEPARATED)
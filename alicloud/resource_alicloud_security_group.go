@@ -20,6 +20,8 @@ func resourceAliyunSecurityGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsAll,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -43,7 +45,8 @@ func resourceAliyunSecurityGroup() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -99,9 +102,7 @@ func resourceAliyunSecurityGroupRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil && !NotFoundError(err) {
 		return fmt.Errorf("[ERROR] DescribeTags for security group got error: %#v", err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
-	}
+	setTagsAll(client, d, tagsToMap(tags))
 
 	return nil
 }
@@ -114,10 +115,11 @@ func resourceAliyunSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 	args := ecs.CreateModifySecurityGroupAttributeRequest()
 	args.SecurityGroupId = d.Id()
 
-	if err := setTags(client, TagResourceSecurityGroup, d); err != nil {
+	if err := setTags(client, &EcsService{client}, TagResourceSecurityGroup, d); err != nil {
 		return fmt.Errorf("Set tags for security group got error: %#v", err)
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("name") && !d.IsNewResource() {
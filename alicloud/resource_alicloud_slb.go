@@ -23,6 +23,8 @@ func resourceAliyunSlb() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsAll,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -222,6 +224,8 @@ func resourceAliyunSlb() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateSlbInstanceTagNum,
 			},
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -304,9 +308,8 @@ func resourceAliyunSlbRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("address", loadBalancer.Address)
 	d.Set("specification", loadBalancer.LoadBalancerSpec)
 
-	tags, _ := slbService.describeTags(d.Id())
-	if len(tags) > 0 {
-		d.Set("tags", slbService.slbTagsToMap(tags))
+	if err := readTags(client, &slbService, TagResourceLoadBalancer, d); err != nil {
+		return err
 	}
 	return nil
 }
@@ -318,9 +321,11 @@ func resourceAliyunSlbUpdate(d *schema.ResourceData, meta interface{}) error {
 	d.Partial(true)
 
 	// set instance tags
-	if err := slbService.setSlbInstanceTags(d); err != nil {
+	if err := setTags(client, &slbService, TagResourceLoadBalancer, d); err != nil {
 		return fmt.Errorf("Set tags for instance got error: %#v", err)
 	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	if d.IsNewResource() {
 		d.Partial(false)
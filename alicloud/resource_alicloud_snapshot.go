@@ -0,0 +1,189 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAliyunSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunSnapshotCreate,
+		Read:   resourceAliyunSnapshotRead,
+		Update: resourceAliyunSnapshotUpdate,
+		Delete: resourceAliyunSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffTagsAll,
+
+		Schema: map[string]*schema.Schema{
+			"disk_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDiskName,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDiskDescription,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
+
+func resourceAliyunSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	args := ecs.CreateCreateSnapshotRequest()
+	args.DiskId = d.Get("disk_id").(string)
+
+	if v, ok := d.GetOk("name"); ok && v.(string) != "" {
+		args.SnapshotName = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok && v.(string) != "" {
+		args.Description = v.(string)
+	}
+	args.ClientToken = buildClientToken("TF-CreateSnapshot")
+
+	var raw interface{}
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.CreateSnapshot(args)
+		})
+		if err != nil {
+			if IsExceptedErrors(err, SnapshotInvalidOperations) {
+				return resource.RetryableError(fmt.Errorf("CreateSnapshot timeout and got an error: %#v", err))
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("CreateSnapshot got an error: %#v", err)
+	}
+	resp, _ := raw.(*ecs.CreateSnapshotResponse)
+	if resp == nil {
+		return fmt.Errorf("CreateSnapshot got a nil response: %#v", resp)
+	}
+
+	d.SetId(resp.SnapshotId)
+
+	if err := ecsService.WaitForSnapshot(d.Id(), SnapshotAccomplished, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("Waitting for snapshot %s %s got an error: %#v.", d.Id(), SnapshotAccomplished, err)
+	}
+
+	return resourceAliyunSnapshotUpdate(d, meta)
+}
+
+func resourceAliyunSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	snapshot, err := ecsService.DescribeSnapshotById(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error DescribeSnapshot: %#v", err)
+	}
+
+	d.Set("disk_id", snapshot.SourceDiskId)
+	d.Set("name", snapshot.SnapshotName)
+	d.Set("description", snapshot.Description)
+	d.Set("status", snapshot.Status)
+
+	return readTags(client, &ecsService, TagResourceSnapshot, d)
+}
+
+func resourceAliyunSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	d.Partial(true)
+
+	if err := setTags(client, &EcsService{client}, TagResourceSnapshot, d); err != nil {
+		return fmt.Errorf("Set tags for snapshot got error: %#v", err)
+	} else {
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	if !d.IsNewResource() && (d.HasChange("name") || d.HasChange("description")) {
+		args := ecs.CreateModifySnapshotAttributeRequest()
+		args.SnapshotId = d.Id()
+		args.SnapshotName = d.Get("name").(string)
+		args.Description = d.Get("description").(string)
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ModifySnapshotAttribute(args)
+		})
+		if err != nil {
+			return fmt.Errorf("ModifySnapshotAttribute got an error: %#v", err)
+		}
+		d.SetPartial("name")
+		d.SetPartial("description")
+	}
+
+	d.Partial(false)
+
+	return resourceAliyunSnapshotRead(d, meta)
+}
+
+func resourceAliyunSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	req := ecs.CreateDeleteSnapshotRequest()
+	req.SnapshotId = d.Id()
+
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DeleteSnapshot(req)
+		})
+		if err != nil {
+			if NotFoundError(err) || IsExceptedErrors(err, SnapshotNotFound) {
+				return nil
+			}
+			if IsExceptedErrors(err, SnapshotInvalidOperations) {
+				return resource.RetryableError(fmt.Errorf("Deleting Snapshot %s timeout and got an error: %#v.", d.Id(), err))
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		if _, err := ecsService.DescribeSnapshotById(d.Id()); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("While deleting snapshot %s, describing snapshot got an error: %#v.", d.Id(), err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Deleting Snapshot %s timeout.", d.Id()))
+	})
+}
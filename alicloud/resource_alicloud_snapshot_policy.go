@@ -0,0 +1,217 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAliyunSnapshotPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunSnapshotPolicyCreate,
+		Read:   resourceAliyunSnapshotPolicyRead,
+		Update: resourceAliyunSnapshotPolicyUpdate,
+		Delete: resourceAliyunSnapshotPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDiskName,
+			},
+
+			"repeat_weekdays": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 7,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validateIntegerInRange(1, 7),
+				},
+			},
+
+			"retention_days": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if days := v.(int); days != -1 && (days < 1 || days > 65536) {
+						errors = append(errors, fmt.Errorf("%q must be -1 to keep the snapshots forever, or between 1 and 65536.", k))
+					}
+					return
+				},
+			},
+
+			"time_points": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 24,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validateIntegerInRange(0, 23),
+				},
+			},
+		},
+	}
+}
+
+func resourceAliyunSnapshotPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	args := ecs.CreateCreateAutoSnapshotPolicyRequest()
+	args.AutoSnapshotPolicyName = d.Get("name").(string)
+	args.RepeatWeekdays = convertIntListToJsonString(d.Get("repeat_weekdays").([]interface{}))
+	args.RetentionDays = requests.NewInteger(d.Get("retention_days").(int))
+	args.TimePoints = convertIntListToJsonString(d.Get("time_points").([]interface{}))
+
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.CreateAutoSnapshotPolicy(args)
+	})
+	if err != nil {
+		return fmt.Errorf("CreateAutoSnapshotPolicy got an error: %#v", err)
+	}
+	resp, _ := raw.(*ecs.CreateAutoSnapshotPolicyResponse)
+	if resp == nil {
+		return fmt.Errorf("CreateAutoSnapshotPolicy got a nil response: %#v", resp)
+	}
+
+	d.SetId(resp.AutoSnapshotPolicyId)
+
+	return resourceAliyunSnapshotPolicyRead(d, meta)
+}
+
+func resourceAliyunSnapshotPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	policy, err := ecsService.DescribeSnapshotPolicyById(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error DescribeAutoSnapshotPolicy: %#v", err)
+	}
+
+	repeatWeekdays, err := parseIntListFromJsonString(policy.RepeatWeekdays)
+	if err != nil {
+		return fmt.Errorf("Parsing the repeat weekdays %s of snapshot policy %s got an error: %#v", policy.RepeatWeekdays, d.Id(), err)
+	}
+	timePoints, err := parseIntListFromJsonString(policy.TimePoints)
+	if err != nil {
+		return fmt.Errorf("Parsing the time points %s of snapshot policy %s got an error: %#v", policy.TimePoints, d.Id(), err)
+	}
+
+	d.Set("name", policy.AutoSnapshotPolicyName)
+	d.Set("repeat_weekdays", repeatWeekdays)
+	d.Set("retention_days", policy.RetentionDays)
+	d.Set("time_points", timePoints)
+
+	return nil
+}
+
+func resourceAliyunSnapshotPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	args := ecs.CreateModifyAutoSnapshotPolicyExRequest()
+	args.AutoSnapshotPolicyId = d.Id()
+	update := false
+
+	if d.HasChange("name") {
+		args.AutoSnapshotPolicyName = d.Get("name").(string)
+		update = true
+	}
+	if d.HasChange("repeat_weekdays") {
+		args.RepeatWeekdays = convertIntListToJsonString(d.Get("repeat_weekdays").([]interface{}))
+		update = true
+	}
+	if d.HasChange("retention_days") {
+		args.RetentionDays = requests.NewInteger(d.Get("retention_days").(int))
+		update = true
+	}
+	if d.HasChange("time_points") {
+		args.TimePoints = convertIntListToJsonString(d.Get("time_points").([]interface{}))
+		update = true
+	}
+
+	if update {
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ModifyAutoSnapshotPolicyEx(args)
+		})
+		if err != nil {
+			return fmt.Errorf("ModifyAutoSnapshotPolicyEx got an error: %#v", err)
+		}
+	}
+
+	return resourceAliyunSnapshotPolicyRead(d, meta)
+}
+
+func resourceAliyunSnapshotPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	req := ecs.CreateDeleteAutoSnapshotPolicyRequest()
+	req.AutoSnapshotPolicyId = d.Id()
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DeleteAutoSnapshotPolicy(req)
+		})
+		if err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			if IsExceptedErrors(err, []string{"OperationConflict", InternalError}) {
+				return resource.RetryableError(fmt.Errorf("Deleting Snapshot Policy %s timeout and got an error: %#v.", d.Id(), err))
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		if _, err := ecsService.DescribeSnapshotPolicyById(d.Id()); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("While deleting snapshot policy %s, describing it got an error: %#v.", d.Id(), err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Deleting Snapshot Policy %s timeout.", d.Id()))
+	})
+}
+
+// convertIntListToJsonString converts a list of integers to the JSON array expected by the ECS snapshot policy APIs, like ["1","2"].
+func convertIntListToJsonString(configured []interface{}) string {
+	values := make([]string, 0, len(configured))
+	for _, v := range configured {
+		values = append(values, strconv.Itoa(v.(int)))
+	}
+	result, _ := json.Marshal(values)
+	return string(result)
+}
+
+// parseIntListFromJsonString parses the JSON arrays returned by the ECS snapshot policy APIs, like ["1","2"].
+func parseIntListFromJsonString(s string) ([]int, error) {
+	var values []string
+	if err := json.Unmarshal([]byte(s), &values); err != nil {
+		return nil, err
+	}
+	result := make([]int, 0, len(values))
+	for _, v := range values {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, i)
+	}
+	return result, nil
+}
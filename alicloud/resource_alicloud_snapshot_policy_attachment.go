@@ -0,0 +1,144 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAliyunSnapshotPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunSnapshotPolicyAttachmentCreate,
+		Read:   resourceAliyunSnapshotPolicyAttachmentRead,
+		Delete: resourceAliyunSnapshotPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"disk_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAliyunSnapshotPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	policyId := d.Get("policy_id").(string)
+	diskId := d.Get("disk_id").(string)
+
+	args := ecs.CreateApplyAutoSnapshotPolicyRequest()
+	args.AutoSnapshotPolicyId = policyId
+	args.DiskIds = convertListToJsonString([]interface{}{diskId})
+
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ApplyAutoSnapshotPolicy(args)
+		})
+		if err != nil {
+			if IsExceptedErrors(err, DiskInvalidOperation) {
+				return resource.RetryableError(fmt.Errorf("Apply Snapshot Policy %s timeout and got an error: %#v", policyId, err))
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Applying snapshot policy %s to disk %s got an error: %#v.", policyId, diskId, err)
+	}
+
+	d.SetId(policyId + COLON_SEPARATED + diskId)
+
+	return resourceAliyunSnapshotPolicyAttachmentRead(d, meta)
+}
+
+func resourceAliyunSnapshotPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	policyId, diskId, err := getSnapshotPolicyIdAndDiskId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	disk, err := ecsService.DescribeDiskById("", diskId)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error DescribeDisk: %#v", err)
+	}
+
+	if disk.AutoSnapshotPolicyId != policyId {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("policy_id", disk.AutoSnapshotPolicyId)
+	d.Set("disk_id", disk.DiskId)
+
+	return nil
+}
+
+func resourceAliyunSnapshotPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	policyId, diskId, err := getSnapshotPolicyIdAndDiskId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	req := ecs.CreateCancelAutoSnapshotPolicyRequest()
+	req.DiskIds = convertListToJsonString([]interface{}{diskId})
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		disk, err := ecsService.DescribeDiskById("", diskId)
+		if err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("While cancelling snapshot policy %s, describing disk %s got an error: %#v.", policyId, diskId, err))
+		}
+
+		if disk.AutoSnapshotPolicyId != policyId {
+			return nil
+		}
+
+		_, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.CancelAutoSnapshotPolicy(req)
+		})
+		if err != nil {
+			if IsExceptedErrors(err, DiskInvalidOperation) {
+				return resource.RetryableError(fmt.Errorf("Cancel Snapshot Policy %s timeout and got an error: %#v", policyId, err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Cancelling snapshot policy %s of disk %s got an error: %#v.", policyId, diskId, err))
+		}
+		time.Sleep(3 * time.Second)
+		return resource.RetryableError(fmt.Errorf("Cancel Snapshot Policy %s timeout.", policyId))
+	})
+}
+
+func getSnapshotPolicyIdAndDiskId(id string) (string, string, error) {
+	parts := strings.Split(id, COLON_SEPARATED)
+
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid resource id %s, expected <policy_id>:<disk_id>", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,184 @@
+package alicloud
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestConvertIntListToJsonString(t *testing.T) {
+	s := convertIntListToJsonString([]interface{}{1, 22, 23})
+	if s != `["1","22","23"]` {
+		t.Fatalf("unexpected JSON string %s", s)
+	}
+	values, err := parseIntListFromJsonString(s)
+	if err != nil {
+		t.Fatalf("parsing %s got an error: %#v", s, err)
+	}
+	if !reflect.DeepEqual(values, []int{1, 22, 23}) {
+		t.Fatalf("unexpected values %#v", values)
+	}
+}
+
+func TestAccAlicloudSnapshotPolicy_basic(t *testing.T) {
+	var v ecs.AutoSnapshotPolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_snapshot_policy.sp",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSnapshotPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotPolicyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotPolicyExists("alicloud_snapshot_policy.sp", &v),
+					resource.TestCheckResourceAttr("alicloud_snapshot_policy.sp", "name", "tf-testAccSnapshotPolicyConfig"),
+					resource.TestCheckResourceAttr("alicloud_snapshot_policy.sp", "repeat_weekdays.#", "3"),
+					resource.TestCheckResourceAttr("alicloud_snapshot_policy.sp", "retention_days", "-1"),
+					resource.TestCheckResourceAttr("alicloud_snapshot_policy.sp", "time_points.#", "3"),
+				),
+			},
+			{
+				Config: testAccSnapshotPolicyConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotPolicyExists("alicloud_snapshot_policy.sp", &v),
+					resource.TestCheckResourceAttr("alicloud_snapshot_policy.sp", "repeat_weekdays.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_snapshot_policy.sp", "repeat_weekdays.0", "7"),
+					resource.TestCheckResourceAttr("alicloud_snapshot_policy.sp", "retention_days", "7"),
+					resource.TestCheckResourceAttr("alicloud_snapshot_policy.sp", "time_points.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_snapshot_policy.sp", "time_points.0", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudSnapshotPolicyAttachment_basic(t *testing.T) {
+	var v ecs.AutoSnapshotPolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_snapshot_policy_attachment.sp-att",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSnapshotPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotPolicyAttachmentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotPolicyExists("alicloud_snapshot_policy.sp", &v),
+					resource.TestCheckResourceAttrSet("alicloud_snapshot_policy_attachment.sp-att", "policy_id"),
+					resource.TestCheckResourceAttrSet("alicloud_snapshot_policy_attachment.sp-att", "disk_id"),
+				),
+			},
+			{
+				ResourceName:      "alicloud_snapshot_policy_attachment.sp-att",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSnapshotPolicyExists(n string, policy *ecs.AutoSnapshotPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Snapshot Policy ID is set")
+		}
+
+		client := testAccProvider.Meta().(*connectivity.AliyunClient)
+		ecsService := EcsService{client}
+
+		v, err := ecsService.DescribeSnapshotPolicyById(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("While checking snapshot policy existing, describing it got an error: %#v.", err)
+		}
+
+		*policy = v
+		return nil
+	}
+}
+
+func testAccCheckSnapshotPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_snapshot_policy" {
+			continue
+		}
+
+		if _, err := ecsService.DescribeSnapshotPolicyById(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("While checking snapshot policy destroy, describing it got an error: %#v.", err)
+		}
+		return fmt.Errorf("ECS Snapshot Policy %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccSnapshotPolicyConfig = `
+resource "alicloud_snapshot_policy" "sp" {
+	name = "tf-testAccSnapshotPolicyConfig"
+	repeat_weekdays = [1, 2, 3]
+	retention_days = -1
+	time_points = [1, 22, 23]
+}
+`
+
+const testAccSnapshotPolicyConfigUpdate = `
+resource "alicloud_snapshot_policy" "sp" {
+	name = "tf-testAccSnapshotPolicyConfig"
+	repeat_weekdays = [7]
+	retention_days = 7
+	time_points = [2]
+}
+`
+
+const testAccSnapshotPolicyAttachmentConfig = `
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_efficiency"
+}
+variable "name" {
+	default = "tf-testAccSnapshotPolicyAttachmentConfig"
+}
+resource "alicloud_disk" "disk" {
+	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+	name = "${var.name}"
+	category = "cloud_efficiency"
+	size = "20"
+}
+resource "alicloud_snapshot_policy" "sp" {
+	name = "${var.name}"
+	repeat_weekdays = [1]
+	retention_days = 7
+	time_points = [1]
+}
+resource "alicloud_snapshot_policy_attachment" "sp-att" {
+	policy_id = "${alicloud_snapshot_policy.sp.id}"
+	disk_id = "${alicloud_disk.disk.id}"
+}
+`
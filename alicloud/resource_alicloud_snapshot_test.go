@@ -0,0 +1,130 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudSnapshot_basic(t *testing.T) {
+	var v ecs.Snapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_snapshot.snapshot",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotConfig(EcsInstanceCommonTestCase, "tf-testAccSnapshotConfig"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("alicloud_snapshot.snapshot", &v),
+					resource.TestCheckResourceAttr("alicloud_snapshot.snapshot", "name", "tf-testAccSnapshotConfig"),
+					resource.TestCheckResourceAttr("alicloud_snapshot.snapshot", "status", "accomplished"),
+					resource.TestCheckResourceAttr("alicloud_snapshot.snapshot", "tags.%", "1"),
+					resource.TestCheckResourceAttrSet("alicloud_snapshot.snapshot", "disk_id"),
+				),
+			},
+			{
+				Config: testAccSnapshotConfig(EcsInstanceCommonTestCase, "tf-testAccSnapshotConfigUpdate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("alicloud_snapshot.snapshot", &v),
+					resource.TestCheckResourceAttr("alicloud_snapshot.snapshot", "name", "tf-testAccSnapshotConfigUpdate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSnapshotExists(n string, snapshot *ecs.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Snapshot ID is set")
+		}
+
+		client := testAccProvider.Meta().(*connectivity.AliyunClient)
+		ecsService := EcsService{client}
+
+		v, err := ecsService.DescribeSnapshotById(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("While checking snapshot existing, describing snapshot got an error: %#v.", err)
+		}
+
+		*snapshot = v
+		return nil
+	}
+}
+
+func testAccCheckSnapshotDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_snapshot" {
+			continue
+		}
+
+		if _, err := ecsService.DescribeSnapshotById(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("While checking snapshot destroy, describing snapshot got an error: %#v.", err)
+		}
+		return fmt.Errorf("ECS Snapshot %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSnapshotConfig(common, name string) string {
+	return fmt.Sprintf(`
+	%s
+	variable "name" {
+		default = "tf-testAccSnapshotConfig"
+	}
+
+	resource "alicloud_disk" "disk" {
+	  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+	  size = "20"
+	  name = "${var.name}"
+	}
+
+	resource "alicloud_instance" "instance" {
+		image_id = "${data.alicloud_images.default.images.0.id}"
+		availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+		system_disk_category = "cloud_efficiency"
+		instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+		security_groups = ["${alicloud_security_group.default.id}"]
+		instance_name = "${var.name}"
+		vswitch_id = "${alicloud_vswitch.default.id}"
+	}
+
+	resource "alicloud_disk_attachment" "disk-att" {
+	  disk_id = "${alicloud_disk.disk.id}"
+	  instance_id = "${alicloud_instance.instance.id}"
+	}
+
+	resource "alicloud_snapshot" "snapshot" {
+	  disk_id = "${alicloud_disk_attachment.disk-att.disk_id}"
+	  name = "%s"
+	  description = "Hello ecs snapshot."
+	  tags {
+	    Name = "TerraformTest"
+	  }
+	}
+	`, common, name)
+}
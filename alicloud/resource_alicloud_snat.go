@@ -2,7 +2,7 @@ package alicloud
 
 import (
 	"fmt"
-
+	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
@@ -17,6 +17,9 @@ func resourceAliyunSnatEntry() *schema.Resource {
 		Read:   resourceAliyunSnatEntryRead,
 		Update: resourceAliyunSnatEntryUpdate,
 		Delete: resourceAliyunSnatEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunSnatEntryImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"snat_table_id": {
@@ -88,6 +91,18 @@ func resourceAliyunSnatEntryRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// resourceAliyunSnatEntryImport imports a snat entry by the id <snat_table_id>:<snat_entry_id>,
+// since the snat table is required to describe the entry.
+func resourceAliyunSnatEntryImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	split := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, fmt.Errorf("Invalid snat entry import id %s, expected <snat_table_id>:<snat_entry_id>.", d.Id())
+	}
+	d.Set("snat_table_id", split[0])
+	d.SetId(split[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAliyunSnatEntryUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	vpcService := VpcService{client}
@@ -17,6 +17,9 @@ func resourceAliyunSslVpnServer() *schema.Resource {
 		Read:   resourceAliyunSslVpnServerRead,
 		Update: resourceAliyunSslVpnServerUpdate,
 		Delete: resourceAliyunSslVpnServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
@@ -21,6 +21,8 @@ func resourceAliyunVpc() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsAll,
+
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
 				Type:         schema.TypeString,
@@ -62,6 +64,10 @@ func resourceAliyunVpc() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -147,6 +153,10 @@ func resourceAliyunVpcRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("route_table_id", "")
 	}
 
+	if err := readTags(client, &vpcService, TagResourceVpc, d); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -154,6 +164,12 @@ func resourceAliyunVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	d.Partial(true)
 
+	if err := setTags(client, &VpcService{client}, TagResourceVpc, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
 	attributeUpdate := false
 	request := vpc.CreateModifyVpcAttributeRequest()
 	request.VpcId = d.Id()
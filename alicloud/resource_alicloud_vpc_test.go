@@ -161,6 +161,39 @@ func TestAccAlicloudVpc_update(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudVpc_tags(t *testing.T) {
+	var vpc vpc.DescribeVpcAttributeResponse
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcConfigTags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("alicloud_vpc.foo", &vpc),
+					resource.TestCheckResourceAttr("alicloud_vpc.foo", "tags.%", "2"),
+					resource.TestCheckResourceAttr("alicloud_vpc.foo", "tags.Name", "tf-testAccVpcConfigTags"),
+					resource.TestCheckResourceAttr("alicloud_vpc.foo", "tags.CostCenter", "test"),
+					resource.TestCheckResourceAttr("alicloud_vpc.foo", "tags_all.%", "2"),
+				),
+			},
+			{
+				Config: testAccVpcConfigTagsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("alicloud_vpc.foo", &vpc),
+					resource.TestCheckResourceAttr("alicloud_vpc.foo", "tags.%", "1"),
+					resource.TestCheckResourceAttr("alicloud_vpc.foo", "tags.CostCenter", "prod"),
+					resource.TestCheckResourceAttr("alicloud_vpc.foo", "tags_all.%", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAlicloudVpc_multi(t *testing.T) {
 	var vpc vpc.DescribeVpcAttributeResponse
 
@@ -254,6 +287,27 @@ resource "alicloud_vpc" "foo" {
 }
 `
 
+const testAccVpcConfigTags = `
+resource "alicloud_vpc" "foo" {
+	cidr_block = "172.16.0.0/12"
+	name = "tf-testAccVpcConfigTags"
+	tags {
+		Name = "tf-testAccVpcConfigTags"
+		CostCenter = "test"
+	}
+}
+`
+
+const testAccVpcConfigTagsUpdate = `
+resource "alicloud_vpc" "foo" {
+	cidr_block = "172.16.0.0/12"
+	name = "tf-testAccVpcConfigTags"
+	tags {
+		CostCenter = "prod"
+	}
+}
+`
+
 const testAccVpcConfigMulti = `
 variable "name" {
   	default = "tf-testAccVpcConfigMulti"
@@ -20,6 +20,8 @@ func resourceAliyunSubnet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffTagsAll,
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -45,6 +47,10 @@ func resourceAliyunSubnet() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -107,6 +113,10 @@ func resourceAliyunSwitchRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", vswitch.VSwitchName)
 	d.Set("description", vswitch.Description)
 
+	if err := readTags(client, &vpcService, TagResourceVSwitch, d); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -115,6 +125,12 @@ func resourceAliyunSwitchUpdate(d *schema.ResourceData, meta interface{}) error
 
 	d.Partial(true)
 
+	if err := setTags(client, &VpcService{client}, TagResourceVSwitch, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
 	attributeUpdate := false
 	request := vpc.CreateModifyVSwitchAttributeRequest()
 	request.VSwitchId = d.Id()
@@ -2,8 +2,8 @@ package alicloud
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
-	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
@@ -54,26 +54,21 @@ func (s *CenService) DescribeCenInstance(cenId string) (c cbn.Cen, err error) {
 }
 
 func (s *CenService) WaitForCenInstance(cenId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		cen, err := s.DescribeCenInstance(cenId)
-		if err != nil {
-			return err
-		}
-		if cen.Status == string(status) {
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("CEN", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+	waiter := &StateWaiter{
+		Product: "CEN",
+		Id:      cenId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			cen, err := s.DescribeCenInstance(cenId)
+			if err != nil {
+				return nil, "", err
+			}
+			return cen, cen.Status, nil
+		},
 	}
-
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *CenService) DescribeCenAttachedChildInstanceById(instanceId, cenId string) (c cbn.ChildInstance, err error) {
@@ -104,51 +99,41 @@ func (s *CenService) DescribeCenAttachedChildInstanceById(instanceId, cenId stri
 }
 
 func (s *CenService) WaitForCenChildInstanceAttached(instanceId string, cenId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		instance, err := s.DescribeCenAttachedChildInstanceById(instanceId, cenId)
-		if err != nil {
-			return err
-		}
-		if instance.Status == string(status) {
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("CEN Child Instance Attachment", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+	waiter := &StateWaiter{
+		Product: "CEN Child Instance Attachment",
+		Id:      fmt.Sprintf("%s%s%s", cenId, COLON_SEPARATED, instanceId),
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			instance, err := s.DescribeCenAttachedChildInstanceById(instanceId, cenId)
+			if err != nil {
+				return nil, "", err
+			}
+			return instance, instance.Status, nil
+		},
 	}
-
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *CenService) WaitForCenChildInstanceDetached(instanceId string, cenId string, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		_, err := s.DescribeCenAttachedChildInstanceById(instanceId, cenId)
-		if err != nil {
-			if NotFoundError(err) {
-				break
-			} else {
-				return err
+	waiter := &StateWaiter{
+		Product: "CEN Child Instance Attachment",
+		Id:      fmt.Sprintf("%s%s%s", cenId, COLON_SEPARATED, instanceId),
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			instance, err := s.DescribeCenAttachedChildInstanceById(instanceId, cenId)
+			if err != nil {
+				if NotFoundError(err) {
+					return nil, "", nil
+				}
+				return nil, "", err
 			}
-		}
-
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(fmt.Sprintf("Waitting for %s detach timeout.", instanceId))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+			return instance, instance.Status, nil
+		},
 	}
-
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *CenService) DescribeCenBandwidthPackage(cenBwpId string) (c cbn.CenBandwidthPackage, err error) {
@@ -184,73 +169,60 @@ func (s *CenService) DescribeCenBandwidthPackage(cenBwpId string) (c cbn.CenBand
 }
 
 func (s *CenService) WaitForCenBandwidthPackage(cenBwpId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		cenBwp, err := s.DescribeCenBandwidthPackage(cenBwpId)
-		if err != nil && !NotFoundError(err) {
-			return err
-		}
-		if cenBwp.Status == string(status) {
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("CEN Bandwidth Package", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+	waiter := &StateWaiter{
+		Product: "CEN Bandwidth Package",
+		Id:      cenBwpId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			cenBwp, err := s.DescribeCenBandwidthPackage(cenBwpId)
+			if err != nil {
+				if NotFoundError(err) {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return cenBwp, cenBwp.Status, nil
+		},
 	}
-
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *CenService) WaitForCenBandwidthPackageUpdate(cenBwpId string, bandwidth int, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		cenBwp, err := s.DescribeCenBandwidthPackage(cenBwpId)
-		if err != nil {
-			return err
-		}
-		if cenBwp.Bandwidth == bandwidth {
-			break
-		}
-
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(fmt.Sprintf("Waitting for CEN bandwidth package update is timeout"))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+	waiter := &StateWaiter{
+		Product: "CEN Bandwidth Package",
+		Id:      cenBwpId,
+		Target:  []string{strconv.Itoa(bandwidth)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			cenBwp, err := s.DescribeCenBandwidthPackage(cenBwpId)
+			if err != nil {
+				return nil, "", err
+			}
+			return cenBwp, strconv.Itoa(cenBwp.Bandwidth), nil
+		},
 	}
-
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *CenService) WaitForCenBandwidthPackageAttachment(cenBwpId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		cenBwp, err := s.DescribeCenBandwidthPackage(cenBwpId)
-		if err != nil {
-			return err
-		}
-		if cenBwp.Status == string(status) {
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("CEN Bandwidth Package Attachment", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+	waiter := &StateWaiter{
+		Product: "CEN Bandwidth Package Attachment",
+		Id:      cenBwpId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			cenBwp, err := s.DescribeCenBandwidthPackage(cenBwpId)
+			if err != nil {
+				return nil, "", err
+			}
+			return cenBwp, cenBwp.Status, nil
+		},
 	}
-
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *CenService) DescribeCenBandwidthPackageById(cenBwpId string) (c cbn.CenBandwidthPackage, err error) {
@@ -313,52 +285,41 @@ func (s *CenService) DescribeCenBandwidthLimit(cenId, localRegionId, oppositeReg
 }
 
 func (s *CenService) WaitForCenInterRegionBandwidthLimitActive(cenId string, localRegionId string, oppositeRegionId string, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		cenBandwidthLimit, err := s.DescribeCenBandwidthLimit(cenId, localRegionId, oppositeRegionId)
-		if err != nil {
-			return err
-		}
-
-		if cenBandwidthLimit.Status == string(Active) {
-			break
-		}
-
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(fmt.Sprintf("Waitting for bandwidth limit CenId %s localRegionId %s oppositeRegionId %s timeout.", cenId, localRegionId, oppositeRegionId))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+	waiter := &StateWaiter{
+		Product: "CEN Bandwidth Limit",
+		Id:      fmt.Sprintf("%s%s%s%s%s", cenId, COLON_SEPARATED, localRegionId, COLON_SEPARATED, oppositeRegionId),
+		Target:  []string{string(Active)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			cenBandwidthLimit, err := s.DescribeCenBandwidthLimit(cenId, localRegionId, oppositeRegionId)
+			if err != nil {
+				return nil, "", err
+			}
+			return cenBandwidthLimit, cenBandwidthLimit.Status, nil
+		},
 	}
-
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *CenService) WaitForCenInterRegionBandwidthLimitDestroy(cenId string, localRegionId string, oppositeRegionId string, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		_, err := s.DescribeCenBandwidthLimit(cenId, localRegionId, oppositeRegionId)
-		if err != nil {
-			if NotFoundError(err) {
-				break
+	waiter := &StateWaiter{
+		Product: "CEN Bandwidth Limit",
+		Id:      fmt.Sprintf("%s%s%s%s%s", cenId, COLON_SEPARATED, localRegionId, COLON_SEPARATED, oppositeRegionId),
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			cenBandwidthLimit, err := s.DescribeCenBandwidthLimit(cenId, localRegionId, oppositeRegionId)
+			if err != nil {
+				if NotFoundError(err) {
+					return nil, "", nil
+				}
+				return nil, "", err
 			}
-			return err
-		}
-
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(fmt.Sprintf("Waitting for bandwidth limit CenId %s localRegionId %s oppositeRegionId %s timeout.", cenId, localRegionId, oppositeRegionId))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+			return cenBandwidthLimit, cenBandwidthLimit.Status, nil
+		},
 	}
-
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *CenService) CreateCenRouteEntryParas(vtbId string) (childInstanceId string, instanceType string, err error) {
@@ -427,28 +388,22 @@ func (s *CenService) DescribePublishedRouteEntriesById(id string) (c cbn.Publish
 }
 
 func (s *CenService) WaitForRouterEntryPublished(id string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		routeEntry, err := s.DescribePublishedRouteEntriesById(id)
-		if err != nil {
-			return nil
-		}
-
-		if string(status) == routeEntry.PublishStatus {
-			break
-		}
-
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("CEN RouteEntries", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+	waiter := &StateWaiter{
+		Product: "CEN RouteEntries",
+		Id:      id,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			routeEntry, err := s.DescribePublishedRouteEntriesById(id)
+			if err != nil {
+				// The route entry may have been withdrawn, and there is nothing to wait for.
+				return routeEntry, string(status), nil
+			}
+			return routeEntry, routeEntry.PublishStatus, nil
+		},
 	}
-
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *CenService) GetCenIdAndAnotherId(id string) (string, string, error) {
@@ -2,6 +2,9 @@ package alicloud
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -113,3 +116,92 @@ func (s *CsService) WaitForContainerApplication(clusterName, appName string, sta
 	}
 	return nil
 }
+
+func (s *CsService) DescribeCsKubernetesNodePool(clusterId, nodePoolId string) (nodePool *NodePoolDetail, err error) {
+	invoker := NewInvoker()
+	err = invoker.Run(func() error {
+		raw, e := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
+			detail := &NodePoolDetail{}
+			err := csClient.Invoke("", http.MethodGet, fmt.Sprintf("/clusters/%s/nodepools/%s", clusterId, nodePoolId), nil, nil, detail)
+			return detail, err
+		})
+		if e != nil {
+			return e
+		}
+		nodePool, _ = raw.(*NodePoolDetail)
+		return nil
+	})
+	if err != nil {
+		if IsExceptedErrors(err, []string{ErrorClusterNotFound, ErrorNodePoolNotFound}) {
+			return nil, GetNotFoundErrorFromString(GetNotFoundMessage("Kubernetes Node Pool", nodePoolId))
+		}
+		return nil, fmt.Errorf("DescribeNodePoolDetail %s got an error: %#v.", nodePoolId, err)
+	}
+	if nodePool == nil || nodePool.NodePoolId != nodePoolId {
+		return nil, GetNotFoundErrorFromString(GetNotFoundMessage("Kubernetes Node Pool", nodePoolId))
+	}
+	return
+}
+
+// DescribeCsKubernetesNodePoolNodes returns all of the nodes in the node pool.
+func (s *CsService) DescribeCsKubernetesNodePoolNodes(clusterId, nodePoolId string) (nodes []KubernetesNodePoolNode, err error) {
+	invoker := NewInvoker()
+	pageNumber := 1
+	for {
+		query := url.Values{}
+		query.Set("nodepool_id", nodePoolId)
+		query.Set("pageNumber", strconv.Itoa(pageNumber))
+		query.Set("pageSize", strconv.Itoa(PageSizeLarge))
+		var response *GetKubernetesNodePoolNodesResponse
+		if err := invoker.Run(func() error {
+			raw, e := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
+				resp := &GetKubernetesNodePoolNodesResponse{}
+				err := csClient.Invoke("", http.MethodGet, fmt.Sprintf("/clusters/%s/nodes", clusterId), query, nil, resp)
+				return resp, err
+			})
+			if e != nil {
+				return e
+			}
+			response, _ = raw.(*GetKubernetesNodePoolNodesResponse)
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("GetKubernetesClusterNodePoolNodes %s got an error: %#v.", nodePoolId, err)
+		}
+		if response == nil {
+			break
+		}
+		nodes = append(nodes, response.Nodes...)
+
+		if len(response.Nodes) < PageSizeLarge {
+			break
+		}
+		pageNumber += 1
+	}
+	return
+}
+
+func (s *CsService) WaitForCsKubernetesNodePool(clusterId, nodePoolId string, status NodePoolState, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	for {
+		nodePool, err := s.DescribeCsKubernetesNodePool(clusterId, nodePoolId)
+		if err != nil {
+			return err
+		}
+
+		if nodePool.State == status {
+			break
+		}
+		if nodePool.State == NodePoolFailed {
+			return fmt.Errorf("Waitting for kubernetes node pool %s %s failed. Looking the specified reason in the web console.", nodePoolId, status)
+		}
+		timeout = timeout - DefaultIntervalMedium
+		if timeout <= 0 {
+			return GetTimeErrorFromString(fmt.Sprintf("Waitting for kubernetes node pool %s is timeout and current status is %s.", string(status), nodePool.State))
+		}
+		time.Sleep(DefaultIntervalMedium * time.Second)
+	}
+	return nil
+}
@@ -459,6 +459,50 @@ func (s *EcsService) DescribeTags(resourceId string, resourceType TagResourceTyp
 	return resp.Tags.Tag, nil
 }
 
+func (s *EcsService) DescribeResourceTags(resourceId string, resourceType TagResourceType) (map[string]string, error) {
+	tags, err := s.DescribeTags(resourceId, resourceType)
+	if err != nil && !NotFoundError(err) {
+		return nil, err
+	}
+	return tagsToMap(tags), nil
+}
+
+func (s *EcsService) AddResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	args := ecs.CreateAddTagsRequest()
+	args.ResourceType = string(resourceType)
+	args.ResourceId = resourceId
+	var addTags []ecs.AddTagsTag
+	for _, t := range tags {
+		addTags = append(addTags, ecs.AddTagsTag{
+			Key:   t.Key,
+			Value: t.Value,
+		})
+	}
+	args.Tag = &addTags
+	_, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.AddTags(args)
+	})
+	return err
+}
+
+func (s *EcsService) RemoveResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	args := ecs.CreateRemoveTagsRequest()
+	args.ResourceType = string(resourceType)
+	args.ResourceId = resourceId
+	var removeTags []ecs.RemoveTagsTag
+	for _, t := range tags {
+		removeTags = append(removeTags, ecs.RemoveTagsTag{
+			Key:   t.Key,
+			Value: t.Value,
+		})
+	}
+	args.Tag = &removeTags
+	_, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.RemoveTags(args)
+	})
+	return err
+}
+
 func (s *EcsService) DescribeImageById(id string) (image ecs.Image, err error) {
 	req := ecs.CreateDescribeImagesRequest()
 	req.ImageId = id
@@ -499,76 +543,69 @@ func (s *EcsService) DescribeNetworkInterfaceById(instanceId string, eniId strin
 
 // WaitForInstance waits for instance to given status
 func (s *EcsService) WaitForEcsInstance(instanceId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
+	waiter := &StateWaiter{
+		Product: "ECS Instance",
+		Id:      instanceId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			instance, err := s.DescribeInstanceById(instanceId)
+			if err != nil {
+				if NotFoundError(err) {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return instance, instance.Status, nil
+		},
 	}
-	for {
-		instance, err := s.DescribeInstanceById(instanceId)
-		if err != nil && !NotFoundError(err) {
-			return err
-		}
-		if instance.Status == string(status) {
-			//Sleep one more time for timing issues
-			time.Sleep(DefaultIntervalMedium * time.Second)
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("ECS Instance", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
-
+	if _, err := waiter.Wait(); err != nil {
+		return err
 	}
+	//Sleep one more time for timing issues
+	time.Sleep(DefaultIntervalMedium * time.Second)
 	return nil
 }
 
-// WaitForInstance waits for instance to given status
+// WaitForEcsDisk waits for disk to given status
 func (s *EcsService) WaitForEcsDisk(diskId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
+	waiter := &StateWaiter{
+		Product: "ECS Disk",
+		Id:      diskId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			disk, err := s.DescribeDiskById("", diskId)
+			if err != nil {
+				return nil, "", err
+			}
+			return disk, disk.Status, nil
+		},
 	}
-	for {
-		instance, err := s.DescribeDiskById("", diskId)
-		if err != nil {
-			return err
-		}
-		if instance.Status == string(status) {
-			//Sleep one more time for timing issues
-			time.Sleep(DefaultIntervalMedium * time.Second)
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("ECS Disk", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
-
+	if _, err := waiter.Wait(); err != nil {
+		return err
 	}
+	//Sleep one more time for timing issues
+	time.Sleep(DefaultIntervalMedium * time.Second)
 	return nil
 }
 
 func (s *EcsService) WaitForEcsNetworkInterface(eniId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		eni, err := s.DescribeNetworkInterfaceById("", eniId)
-		if err != nil {
-			return err
-		}
-		if eni.Status == string(status) {
-			break
-		}
-
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("ECS eni", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+	waiter := &StateWaiter{
+		Product: "ECS eni",
+		Id:      eniId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			eni, err := s.DescribeNetworkInterfaceById("", eniId)
+			if err != nil {
+				return nil, "", err
+			}
+			return eni, eni.Status, nil
+		},
 	}
-
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *EcsService) QueryPrivateIps(eniId string) ([]string, error) {
@@ -587,59 +624,55 @@ func (s *EcsService) QueryPrivateIps(eniId string) ([]string, error) {
 }
 
 func (s *EcsService) WaitForPrivateIpsCountChanged(eniId string, count int) error {
-	deadline := time.Now().Add(DefaultTimeout * time.Second)
-	for {
-		if time.Now().After(deadline) {
-			return fmt.Errorf("Wait for private IP addrsses count changed timeout")
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
-
-		ips, err := s.QueryPrivateIps(eniId)
-		if err != nil {
-			return fmt.Errorf("Query private IP failed, %s", err)
-		}
-		if len(ips) == count {
-			return nil
-		}
+	waiter := &StateWaiter{
+		Product: "ECS eni private IP addresses",
+		Id:      eniId,
+		Target:  []string{fmt.Sprintf("%d", count)},
+		Delay:   DefaultIntervalShort * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			ips, err := s.QueryPrivateIps(eniId)
+			if err != nil {
+				return nil, "", fmt.Errorf("Query private IP failed, %s", err)
+			}
+			return ips, fmt.Sprintf("%d", len(ips)), nil
+		},
 	}
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *EcsService) WaitForPrivateIpsListChanged(eniId string, ipList []string) error {
-	deadline := time.Now().Add(DefaultTimeout * time.Second)
-	for {
-		if time.Now().After(deadline) {
-			return fmt.Errorf("Wait for private IP addrsses list changed timeout")
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
-
-		ips, err := s.QueryPrivateIps(eniId)
-		if err != nil {
-			return fmt.Errorf("Query private IP failed, %s", err)
-		}
-
-		if len(ips) != len(ipList) {
-			continue
-		}
+	waiter := &StateWaiter{
+		Product: "ECS eni private IP addresses",
+		Id:      eniId,
+		Target:  []string{"Changed"},
+		Delay:   DefaultIntervalShort * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			ips, err := s.QueryPrivateIps(eniId)
+			if err != nil {
+				return nil, "", fmt.Errorf("Query private IP failed, %s", err)
+			}
 
-		diff := false
-		for i := range ips {
-			exist := false
-			for j := range ipList {
-				if ips[i] == ipList[j] {
-					exist = true
-					break
-				}
+			if len(ips) != len(ipList) {
+				return ips, "Changing", nil
 			}
-			if !exist {
-				diff = true
-				break
+			for i := range ips {
+				exist := false
+				for j := range ipList {
+					if ips[i] == ipList[j] {
+						exist = true
+						break
+					}
+				}
+				if !exist {
+					return ips, "Changing", nil
+				}
 			}
-		}
-
-		if !diff {
-			return nil
-		}
+			return ips, "Changed", nil
+		},
 	}
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *EcsService) AttachKeyPair(keyname string, instanceIds []interface{}) error {
@@ -660,3 +693,217 @@ func (s *EcsService) AttachKeyPair(keyname string, instanceIds []interface{}) er
 		return nil
 	})
 }
+
+func (s *EcsService) DescribeResourcesByTags(resourceType TagResourceType, tags map[string]string) (ids []string, err error) {
+	req := ecs.CreateDescribeResourceByTagsRequest()
+	req.ResourceType = string(resourceType)
+	var reqTags []ecs.DescribeResourceByTagsTag
+	for k, v := range tags {
+		reqTags = append(reqTags, ecs.DescribeResourceByTagsTag{Key: k, Value: v})
+	}
+	req.Tag = &reqTags
+	req.PageSize = requests.NewInteger(PageSizeLarge)
+	req.PageNumber = requests.NewInteger(1)
+
+	for {
+		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeResourceByTags(req)
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp, _ := raw.(*ecs.DescribeResourceByTagsResponse)
+		if resp == nil || len(resp.Resources.Resource) < 1 {
+			break
+		}
+		for _, r := range resp.Resources.Resource {
+			ids = append(ids, r.ResourceId)
+		}
+		if len(resp.Resources.Resource) < PageSizeLarge {
+			break
+		}
+		if page, err := getNextpageNumber(req.PageNumber); err != nil {
+			return nil, err
+		} else {
+			req.PageNumber = page
+		}
+	}
+	return ids, nil
+}
+
+func (s *EcsService) DescribeSnapshotById(snapshotId string) (snapshot ecs.Snapshot, err error) {
+	req := ecs.CreateDescribeSnapshotsRequest()
+	req.SnapshotIds = convertListToJsonString([]interface{}{snapshotId})
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeSnapshots(req)
+	})
+	if err != nil {
+		return
+	}
+	resp, _ := raw.(*ecs.DescribeSnapshotsResponse)
+	if resp == nil || len(resp.Snapshots.Snapshot) < 1 {
+		return snapshot, GetNotFoundErrorFromString(GetNotFoundMessage("Snapshot", snapshotId))
+	}
+	return resp.Snapshots.Snapshot[0], nil
+}
+
+// WaitForSnapshot waits for snapshot to given status
+func (s *EcsService) WaitForSnapshot(snapshotId string, status Status, timeout int) error {
+	waiter := &StateWaiter{
+		Product: "ECS Snapshot",
+		Id:      snapshotId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			snapshot, err := s.DescribeSnapshotById(snapshotId)
+			if err != nil {
+				if NotFoundError(err) {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			if snapshot.Status == string(SnapshotFailed) {
+				return nil, "", fmt.Errorf("Snapshot %s is %s.", snapshotId, snapshot.Status)
+			}
+			return snapshot, snapshot.Status, nil
+		},
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (s *EcsService) DescribeSnapshotPolicyById(policyId string) (policy ecs.AutoSnapshotPolicy, err error) {
+	req := ecs.CreateDescribeAutoSnapshotPolicyExRequest()
+	req.AutoSnapshotPolicyId = policyId
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeAutoSnapshotPolicyEx(req)
+	})
+	if err != nil {
+		return
+	}
+	resp, _ := raw.(*ecs.DescribeAutoSnapshotPolicyExResponse)
+	if resp == nil || len(resp.AutoSnapshotPolicies.AutoSnapshotPolicy) < 1 {
+		return policy, GetNotFoundErrorFromString(GetNotFoundMessage("Snapshot Policy", policyId))
+	}
+	return resp.AutoSnapshotPolicies.AutoSnapshotPolicy[0], nil
+}
+
+// DescribeImageInRegion describes a custom image in any status. The image is looked for in the region of
+// the client when regionId is empty, which lets the copies of an image in other regions be described as well.
+func (s *EcsService) DescribeImageInRegion(regionId, imageId string) (image ecs.Image, err error) {
+	req := ecs.CreateDescribeImagesRequest()
+	setEcsRequestRegion(req.RpcRequest, regionId)
+	req.ImageId = imageId
+	req.ImageOwnerAlias = "self"
+	req.Status = strings.Join([]string{string(Creating), string(Available), string(ImageUnAvailable), string(ImageCreateFailed)}, ",")
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeImages(req)
+	})
+	if err != nil {
+		return
+	}
+	resp, _ := raw.(*ecs.DescribeImagesResponse)
+	if resp == nil || len(resp.Images.Image) < 1 {
+		return image, GetNotFoundErrorFromString(GetNotFoundMessage("Image", imageId))
+	}
+	return resp.Images.Image[0], nil
+}
+
+// WaitForImage waits for image to given status
+func (s *EcsService) WaitForImage(regionId, imageId string, status Status, timeout int) error {
+	waiter := &StateWaiter{
+		Product: "ECS Image",
+		Id:      imageId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			image, err := s.DescribeImageInRegion(regionId, imageId)
+			if err != nil {
+				if NotFoundError(err) {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			if image.Status == string(ImageCreateFailed) {
+				return nil, "", fmt.Errorf("Image %s is %s.", imageId, image.Status)
+			}
+			return image, image.Status, nil
+		},
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (s *EcsService) DescribeImageShareAccounts(imageId string) (accounts []string, err error) {
+	req := ecs.CreateDescribeImageSharePermissionRequest()
+	req.ImageId = imageId
+	req.PageSize = requests.NewInteger(PageSizeLarge)
+	req.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeImageSharePermission(req)
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp, _ := raw.(*ecs.DescribeImageSharePermissionResponse)
+		if resp == nil || len(resp.Accounts.Account) < 1 {
+			break
+		}
+		for _, account := range resp.Accounts.Account {
+			accounts = append(accounts, account.AliyunId)
+		}
+		if len(resp.Accounts.Account) < PageSizeLarge {
+			break
+		}
+		if page, err := getNextpageNumber(req.PageNumber); err != nil {
+			return nil, err
+		} else {
+			req.PageNumber = page
+		}
+	}
+	return accounts, nil
+}
+
+// setEcsRequestRegion sends an ECS request to another region than the one of the client.
+func setEcsRequestRegion(req *requests.RpcRequest, regionId string) {
+	if regionId == "" {
+		return
+	}
+	req.RegionId = regionId
+	req.QueryParams["RegionId"] = regionId
+}
+
+func (s *EcsService) DescribeLaunchTemplateById(templateId string) (template ecs.LaunchTemplateSet, err error) {
+	req := ecs.CreateDescribeLaunchTemplatesRequest()
+	req.LaunchTemplateId = &[]string{templateId}
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeLaunchTemplates(req)
+	})
+	if err != nil {
+		return
+	}
+	resp, _ := raw.(*ecs.DescribeLaunchTemplatesResponse)
+	if resp == nil || len(resp.LaunchTemplateSets.LaunchTemplateSet) < 1 {
+		return template, GetNotFoundErrorFromString(GetNotFoundMessage("Launch Template", templateId))
+	}
+	return resp.LaunchTemplateSets.LaunchTemplateSet[0], nil
+}
+
+func (s *EcsService) DescribeLaunchTemplateVersion(templateId string, version int) (templateVersion ecs.LaunchTemplateVersionSet, err error) {
+	req := ecs.CreateDescribeLaunchTemplateVersionsRequest()
+	req.LaunchTemplateId = templateId
+	req.LaunchTemplateVersion = &[]string{strconv.Itoa(version)}
+	req.DetailFlag = requests.NewBoolean(true)
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeLaunchTemplateVersions(req)
+	})
+	if err != nil {
+		return
+	}
+	resp, _ := raw.(*ecs.DescribeLaunchTemplateVersionsResponse)
+	if resp == nil || len(resp.LaunchTemplateVersionSets.LaunchTemplateVersionSet) < 1 {
+		return templateVersion, GetNotFoundErrorFromString(GetNotFoundMessage("Launch Template Version", fmt.Sprintf("%s:%d", templateId, version)))
+	}
+	return resp.LaunchTemplateVersionSets.LaunchTemplateVersionSet[0], nil
+}
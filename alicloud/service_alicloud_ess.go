@@ -352,28 +352,21 @@ func (srv *EssService) EssRemoveInstances(groupId string, instanceIds []string)
 
 // WaitForScalingGroup waits for group to given status
 func (s *EssService) WaitForScalingGroup(groupId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-	for {
-		sg, err := s.DescribeScalingGroupById(groupId)
-		if err != nil {
-			return err
-		}
-
-		if sg.LifecycleState == string(status) {
-			break
-		}
-
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("Scaling Group", string(status)))
-		}
-
-		time.Sleep(DefaultIntervalShort * time.Second)
-
+	waiter := &StateWaiter{
+		Product: "Scaling Group",
+		Id:      groupId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			sg, err := s.DescribeScalingGroupById(groupId)
+			if err != nil {
+				return nil, "", err
+			}
+			return sg, sg.LifecycleState, nil
+		},
 	}
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 // ess dimensions to map
@@ -1,11 +1,16 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"time"
 
 	"strings"
 
 	"github.com/aliyun/fc-go-sdk"
+	"github.com/go-resty/resty"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
@@ -32,13 +37,59 @@ func (s *FcService) DescribeFcService(name string) (service *fc.GetServiceOutput
 	return
 }
 
-func (s *FcService) DescribeFcFunction(service, name string) (function *fc.GetFunctionOutput, err error) {
+// sendRequest sends the request of the FC APIs which are not supported by the vendored fc-go-sdk. It is signed in the same
+// way as the SDK does, and the response is unmarshalled into output if it is not nil.
+func (s *FcService) sendRequest(method, path string, query url.Values, payload, output interface{}) error {
 	raw, err := s.client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
-		return fcClient.GetFunction(&fc.GetFunctionInput{
-			ServiceName:  &service,
-			FunctionName: &name,
-		})
+		config := fcClient.Config
+		fcPath := "/" + config.APIVersion + path
+		_, host := fc.GetAccessPoint(config.Endpoint)
+		headers := map[string]string{
+			"Host":                 host,
+			fc.HTTPHeaderAccountID: config.AccountID,
+			fc.HTTPHeaderUserAgent: config.UserAgent,
+			"Accept":               "application/json",
+		}
+		var body interface{}
+		if payload != nil {
+			b, err := json.Marshal(payload)
+			if err != nil {
+				return nil, err
+			}
+			headers[fc.HTTPHeaderContentType] = "application/json"
+			headers[fc.HTTPHeaderContentMD5] = fc.MD5(b)
+			body = b
+		}
+		headers[fc.HTTPHeaderDate] = time.Now().UTC().Format(http.TimeFormat)
+		if config.SecurityToken != "" {
+			headers[fc.HTTPHeaderSecurityToken] = config.SecurityToken
+		}
+		headers["Authorization"] = fc.GetAuthStr(config.AccessKeyID, config.AccessKeySecret, method, headers, fcPath)
+		return fcClient.Connect.SendRequest(config.Endpoint+fcPath, method, body, headers, query)
 	})
+	if err != nil {
+		return err
+	}
+	resp, _ := raw.(*resty.Response)
+	if resp.StatusCode() >= 300 {
+		serviceError := &fc.ServiceError{
+			RequestID:  resp.Header().Get(fc.HTTPHeaderRequestID),
+			HTTPStatus: resp.StatusCode(),
+		}
+		json.Unmarshal(resp.Body(), serviceError)
+		return serviceError
+	}
+	if output != nil {
+		if err := json.Unmarshal(resp.Body(), output); err != nil {
+			return fmt.Errorf("Unmarshalling the response of %s %s got an error: %#v.", method, path, err)
+		}
+	}
+	return nil
+}
+
+func (s *FcService) DescribeFcFunction(service, name string) (function *fcFunction, err error) {
+	function = &fcFunction{}
+	err = s.sendRequest(http.MethodGet, fcPath(fcSingleFunctionPath, service, name), nil, nil, function)
 	if err != nil {
 		if IsExceptedErrors(err, []string{ServiceNotFound, FunctionNotFound}) {
 			err = GetNotFoundErrorFromString(GetNotFoundMessage("FC Function", name))
@@ -47,17 +98,30 @@ func (s *FcService) DescribeFcFunction(service, name string) (function *fc.GetFu
 		}
 		return
 	}
-	function, _ = raw.(*fc.GetFunctionOutput)
-	if function == nil || *function.FunctionName == "" {
+	if function.FunctionName == nil || *function.FunctionName == "" {
 		err = GetNotFoundErrorFromString(GetNotFoundMessage("FC Function", name))
 	}
 	return
 }
 
-func (s *FcService) DescribeFcTrigger(service, function, name string) (trigger *fc.GetTriggerOutput, err error) {
-	raw, err := s.client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
-		return fcClient.GetTrigger(fc.NewGetTriggerInput(service, function, name))
-	})
+func (s *FcService) CreateFcFunction(service string, object fcFunctionCreateObject) (*fcFunction, error) {
+	function := &fcFunction{}
+	if err := s.sendRequest(http.MethodPost, fcPath(fcFunctionsPath, service), nil, object, function); err != nil {
+		return nil, err
+	}
+	return function, nil
+}
+
+func (s *FcService) UpdateFcFunction(service, name string, object *fcFunctionUpdateObject) error {
+	if err := s.sendRequest(http.MethodPut, fcPath(fcSingleFunctionPath, service, name), nil, object, nil); err != nil {
+		return fmt.Errorf("UpdateFunction %s got an error: %#v.", name, err)
+	}
+	return nil
+}
+
+func (s *FcService) DescribeFcTrigger(service, function, name string) (trigger *fcTrigger, err error) {
+	trigger = &fcTrigger{}
+	err = s.sendRequest(http.MethodGet, fcPath(fcSingleTriggerPath, service, function, name), nil, nil, trigger)
 	if err != nil {
 		if IsExceptedErrors(err, []string{ServiceNotFound, FunctionNotFound, TriggerNotFound}) {
 			err = GetNotFoundErrorFromString(GetNotFoundMessage("FC Trigger", name))
@@ -66,13 +130,120 @@ func (s *FcService) DescribeFcTrigger(service, function, name string) (trigger *
 		}
 		return
 	}
-	trigger, _ = raw.(*fc.GetTriggerOutput)
-	if trigger == nil || *trigger.TriggerName == "" {
+	if trigger.TriggerName == nil || *trigger.TriggerName == "" {
 		err = GetNotFoundErrorFromString(GetNotFoundMessage("FC Trigger", name))
 	}
 	return
 }
 
+func (s *FcService) CreateFcTrigger(service, function string, object fcTriggerCreateObject) (*fcTrigger, error) {
+	trigger := &fcTrigger{}
+	if err := s.sendRequest(http.MethodPost, fcPath(fcTriggersPath, service, function), nil, object, trigger); err != nil {
+		return nil, err
+	}
+	return trigger, nil
+}
+
+func (s *FcService) UpdateFcTrigger(service, function, name string, object *fcTriggerUpdateObject) error {
+	if err := s.sendRequest(http.MethodPut, fcPath(fcSingleTriggerPath, service, function, name), nil, object, nil); err != nil {
+		return fmt.Errorf("UpdateTrigger %s got an error: %#v.", name, err)
+	}
+	return nil
+}
+
+// DescribeFcServiceVersion looks the version up by listing the versions from it, since there is no API to get a version.
+func (s *FcService) DescribeFcServiceVersion(service, versionId string) (version *fcServiceVersion, err error) {
+	query := url.Values{}
+	query.Set("startKey", versionId)
+	query.Set("limit", "1")
+	var versions fcServiceVersions
+	if err = s.sendRequest(http.MethodGet, fcPath(fcVersionsPath, service), query, nil, &versions); err != nil {
+		if IsExceptedErrors(err, []string{ServiceNotFound}) {
+			err = GetNotFoundErrorFromString(GetNotFoundMessage("FC Service Version", versionId))
+		} else {
+			err = fmt.Errorf("ListServiceVersions %s got an error: %#v.", service, err)
+		}
+		return
+	}
+	if len(versions.Versions) < 1 || StringValue(versions.Versions[0].VersionID) != versionId {
+		err = GetNotFoundErrorFromString(GetNotFoundMessage("FC Service Version", versionId))
+		return
+	}
+	return &versions.Versions[0], nil
+}
+
+func (s *FcService) PublishFcServiceVersion(service, description string) (*fcServiceVersion, error) {
+	version := &fcServiceVersion{}
+	payload := map[string]string{"description": description}
+	if err := s.sendRequest(http.MethodPost, fcPath(fcVersionsPath, service), nil, payload, version); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+func (s *FcService) DeleteFcServiceVersion(service, versionId string) error {
+	return s.sendRequest(http.MethodDelete, fcPath(fcSingleVersionPath, service, versionId), nil, nil, nil)
+}
+
+func (s *FcService) DescribeFcAlias(service, name string) (alias *fcAlias, err error) {
+	alias = &fcAlias{}
+	err = s.sendRequest(http.MethodGet, fcPath(fcSingleAliasPath, service, name), nil, nil, alias)
+	if err != nil {
+		if IsExceptedErrors(err, []string{ServiceNotFound, AliasNotFound}) {
+			err = GetNotFoundErrorFromString(GetNotFoundMessage("FC Alias", name))
+		} else {
+			err = fmt.Errorf("GetAlias %s got an error: %#v.", name, err)
+		}
+		return
+	}
+	if alias.AliasName == nil || *alias.AliasName == "" {
+		err = GetNotFoundErrorFromString(GetNotFoundMessage("FC Alias", name))
+	}
+	return
+}
+
+func (s *FcService) CreateFcAlias(service string, object *fcAliasObject) error {
+	return s.sendRequest(http.MethodPost, fcPath(fcAliasesPath, service), nil, object, nil)
+}
+
+func (s *FcService) UpdateFcAlias(service, name string, object *fcAliasObject) error {
+	if err := s.sendRequest(http.MethodPut, fcPath(fcSingleAliasPath, service, name), nil, object, nil); err != nil {
+		return fmt.Errorf("UpdateAlias %s got an error: %#v.", name, err)
+	}
+	return nil
+}
+
+func (s *FcService) DeleteFcAlias(service, name string) error {
+	return s.sendRequest(http.MethodDelete, fcPath(fcSingleAliasPath, service, name), nil, nil, nil)
+}
+
+// DescribeFcProvisionConfig returns the provision config of the function with the qualifier.
+// The config whose target is 0 is regarded as not found, since it is the same as no provisioned instances.
+func (s *FcService) DescribeFcProvisionConfig(service, qualifier, function string) (config *fcProvisionConfig, err error) {
+	config = &fcProvisionConfig{}
+	err = s.sendRequest(http.MethodGet, fcPath(fcProvisionConfigPath, service, qualifier, function), nil, nil, config)
+	if err != nil {
+		if IsExceptedErrors(err, []string{ServiceNotFound, FunctionNotFound, AliasNotFound}) {
+			err = GetNotFoundErrorFromString(GetNotFoundMessage("FC Provision Config", function))
+		} else {
+			err = fmt.Errorf("GetProvisionConfig %s got an error: %#v.", function, err)
+		}
+		return
+	}
+	if config.Target == nil || *config.Target == 0 {
+		err = GetNotFoundErrorFromString(GetNotFoundMessage("FC Provision Config", function))
+	}
+	return
+}
+
+func (s *FcService) PutFcProvisionConfig(service, qualifier, function string, target int64) error {
+	path := fcPath(fcProvisionConfigPath, service, qualifier, function)
+	if err := s.sendRequest(http.MethodPut, path, nil, &fcProvisionConfig{Target: &target}, nil); err != nil {
+		return fmt.Errorf("PutProvisionConfig %s got an error: %#v.", function, err)
+	}
+	return nil
+}
+
 func removeSpaceAndEnter(s string) string {
 	if Trim(s) == "" {
 		return Trim(s)
@@ -1,10 +1,15 @@
 package alicloud
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
 	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
@@ -76,3 +81,230 @@ func (s *KvstoreService) WaitForRKVInstance(instanceId string, status Status, ti
 	}
 	return nil
 }
+
+func (s *KvstoreService) newTagRequest(action string) *requests.CommonRequest {
+	// Get product code from the built request
+	kvstoreReq := r_kvstore.CreateDescribeInstancesRequest()
+	request := s.client.NewCommonRequest(kvstoreReq.GetProduct(), kvstoreReq.GetLocationServiceCode(), strings.ToUpper(string(Https)), connectivity.ApiVersion20150101)
+	request.ApiName = action
+	return request
+}
+
+func (s *KvstoreService) DescribeResourceTags(resourceId string, resourceType TagResourceType) (map[string]string, error) {
+	tags := make(map[string]string)
+	nextToken := ""
+	for {
+		request := s.newTagRequest("ListTagResources")
+		tagResourcesParams(request, resourceId, resourceType, nil)
+		if nextToken != "" {
+			request.QueryParams["NextToken"] = nextToken
+		}
+		raw, err := s.client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+			return rkvClient.ProcessCommonRequest(request)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if nextToken, err = parseListTagResources(raw, tags); err != nil {
+			return nil, err
+		}
+		if nextToken == "" {
+			return tags, nil
+		}
+	}
+}
+
+func (s *KvstoreService) AddResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	request := s.newTagRequest("TagResources")
+	tagResourcesParams(request, resourceId, resourceType, tags)
+	_, err := s.client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+		return rkvClient.ProcessCommonRequest(request)
+	})
+	return err
+}
+
+func (s *KvstoreService) RemoveResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	request := s.newTagRequest("UntagResources")
+	tagResourcesParams(request, resourceId, resourceType, tags)
+	_, err := s.client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+		return rkvClient.ProcessCommonRequest(request)
+	})
+	return err
+}
+
+// DescribeRKVInstanceShardCount returns the number of the shards of a cluster instance.
+func (s *KvstoreService) DescribeRKVInstanceShardCount(id string) (int, error) {
+	request := r_kvstore.CreateDescribeInstanceAttributeRequest()
+	request.InstanceId = id
+	raw, err := s.client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+		return rkvClient.DescribeInstanceAttribute(request)
+	})
+	if err != nil {
+		if IsExceptedError(err, InvalidKVStoreInstanceIdNotFound) {
+			return 0, GetNotFoundErrorFromString(GetNotFoundMessage("KVStore instance", id))
+		}
+		return 0, err
+	}
+	resp, _ := raw.(*r_kvstore.DescribeInstanceAttributeResponse)
+	// ShardCount is not supported by the SDK yet, so the response content is parsed again.
+	var result struct {
+		Instances struct {
+			DBInstanceAttribute []struct {
+				ShardCount int `json:"ShardCount"`
+			} `json:"DBInstanceAttribute"`
+		} `json:"Instances"`
+	}
+	if err := json.Unmarshal(resp.GetHttpContentBytes(), &result); err != nil {
+		return 0, fmt.Errorf("Unmarshalling the KVStore instance got an error: %#v", err)
+	}
+	if len(result.Instances.DBInstanceAttribute) <= 0 {
+		return 0, GetNotFoundErrorFromString(GetNotFoundMessage("KVStore instance", id))
+	}
+	return result.Instances.DBInstanceAttribute[0].ShardCount, nil
+}
+
+// WaitForRKVInstanceClass waits for the instance class changed and the instance Normal again. The instance keeps
+// Normal for a while after the spec is modified, so the status alone is not enough.
+func (s *KvstoreService) WaitForRKVInstanceClass(instanceId, instanceClass string, timeout int) error {
+	waiter := &StateWaiter{
+		Product:      "KVStore instance",
+		Id:           instanceId,
+		Target:       []string{string(Normal)},
+		Timeout:      timeoutSeconds(timeout),
+		PollInterval: DefaultIntervalMedium * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			instance, err := s.DescribeRKVInstanceById(instanceId)
+			if err != nil {
+				return nil, "", err
+			}
+			if instance.InstanceClass != instanceClass {
+				return instance, string(Changing), nil
+			}
+			return instance, instance.InstanceStatus, nil
+		},
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+// ModifyRKVInstanceParameters applies the changed parameters of the attribute to the instance.
+func (s *KvstoreService) ModifyRKVInstanceParameters(d *schema.ResourceData, attribute string, timeout int) error {
+	o, n := d.GetChange(attribute)
+	oldParameters := make(map[string]string)
+	for _, raw := range o.(*schema.Set).List() {
+		parameter := raw.(map[string]interface{})
+		oldParameters[parameter["name"].(string)] = parameter["value"].(string)
+	}
+	changed := make(map[string]string)
+	for _, raw := range n.(*schema.Set).List() {
+		parameter := raw.(map[string]interface{})
+		name, value := parameter["name"].(string), parameter["value"].(string)
+		if old, ok := oldParameters[name]; !ok || old != value {
+			changed[name] = value
+		}
+	}
+	if len(changed) < 1 {
+		return nil
+	}
+
+	config, err := json.Marshal(changed)
+	if err != nil {
+		return err
+	}
+	request := r_kvstore.CreateModifyInstanceConfigRequest()
+	request.InstanceId = d.Id()
+	request.Config = string(config)
+
+	if err := s.WaitForRKVInstance(d.Id(), Normal, timeout); err != nil {
+		return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
+	}
+	_, err = s.client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+		return rkvClient.ModifyInstanceConfig(request)
+	})
+	if err != nil {
+		return fmt.Errorf("ModifyInstanceConfig got an error: %#v", err)
+	}
+	if err := s.WaitForRKVInstance(d.Id(), Normal, timeout); err != nil {
+		return fmt.Errorf("WaitForInstance %s got error: %#v", Normal, err)
+	}
+	return nil
+}
+
+// RefreshRKVInstanceParameters reads the values of the parameters which are set in the attribute.
+func (s *KvstoreService) RefreshRKVInstanceParameters(d *schema.ResourceData, attribute string) error {
+	configured := d.Get(attribute).(*schema.Set).List()
+	if len(configured) < 1 {
+		return nil
+	}
+
+	request := r_kvstore.CreateDescribeInstanceConfigRequest()
+	request.InstanceId = d.Id()
+	raw, err := s.client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+		return rkvClient.DescribeInstanceConfig(request)
+	})
+	if err != nil {
+		return fmt.Errorf("DescribeInstanceConfig got an error: %#v", err)
+	}
+	resp, _ := raw.(*r_kvstore.DescribeInstanceConfigResponse)
+	// the numeric values are kept as they are, e.g. 1000000 instead of 1e+06
+	values := make(map[string]interface{})
+	decoder := json.NewDecoder(strings.NewReader(resp.Config))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil {
+		return fmt.Errorf("Unmarshalling the KVStore instance config got an error: %#v", err)
+	}
+
+	var parameters []map[string]interface{}
+	for _, raw := range configured {
+		name := raw.(map[string]interface{})["name"].(string)
+		if value, ok := values[name]; ok {
+			parameters = append(parameters, map[string]interface{}{
+				"name":  name,
+				"value": fmt.Sprint(value),
+			})
+		}
+	}
+	return d.Set(attribute, parameters)
+}
+
+func (s *KvstoreService) DescribeRKVAccount(instanceId, accountName string) (*r_kvstore.Account, error) {
+	request := r_kvstore.CreateDescribeAccountsRequest()
+	request.InstanceId = instanceId
+	request.AccountName = accountName
+	raw, err := s.client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+		return rkvClient.DescribeAccounts(request)
+	})
+	if err != nil {
+		if IsExceptedErrors(err, []string{InvalidKVStoreInstanceIdNotFound, InvalidAccountNameNotFound}) {
+			return nil, GetNotFoundErrorFromString(GetNotFoundMessage("KVStore account", fmt.Sprintf("%s%s%s", instanceId, COLON_SEPARATED, accountName)))
+		}
+		return nil, err
+	}
+	resp, _ := raw.(*r_kvstore.DescribeAccountsResponse)
+	if resp == nil || len(resp.Accounts.Account) <= 0 {
+		return nil, GetNotFoundErrorFromString(GetNotFoundMessage("KVStore account", fmt.Sprintf("%s%s%s", instanceId, COLON_SEPARATED, accountName)))
+	}
+	return &resp.Accounts.Account[0], nil
+}
+
+func (s *KvstoreService) WaitForRKVAccount(instanceId, accountName string, status Status, timeout int) error {
+	waiter := &StateWaiter{
+		Product:      "KVStore account",
+		Id:           fmt.Sprintf("%s%s%s", instanceId, COLON_SEPARATED, accountName),
+		Target:       []string{string(status)},
+		Timeout:      timeoutSeconds(timeout),
+		PollInterval: DefaultIntervalMedium * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			account, err := s.DescribeRKVAccount(instanceId, accountName)
+			if err != nil {
+				if NotFoundError(err) {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			return account, account.AccountStatus, nil
+		},
+	}
+	_, err := waiter.Wait()
+	return err
+}
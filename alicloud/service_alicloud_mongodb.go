@@ -0,0 +1,135 @@
+package alicloud
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+	"github.com/denverdino/aliyungo/common"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+type MongoDBService struct {
+	client *connectivity.AliyunClient
+}
+
+func (s *MongoDBService) DescribeMongoDBInstance(id string) (instance dds.DBInstance, err error) {
+	request := dds.CreateDescribeDBInstanceAttributeRequest()
+	request.DBInstanceId = id
+	raw, err := s.client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+		return ddsClient.DescribeDBInstanceAttribute(request)
+	})
+	if err != nil {
+		if IsExceptedError(err, InvalidMongoDBInstanceIdNotFound) {
+			err = GetNotFoundErrorFromString(GetNotFoundMessage("MongoDB instance", id))
+		}
+		return
+	}
+	resp, _ := raw.(*dds.DescribeDBInstanceAttributeResponse)
+	if resp == nil || len(resp.DBInstances.DBInstance) <= 0 {
+		return instance, GetNotFoundErrorFromString(GetNotFoundMessage("MongoDB instance", id))
+	}
+
+	return resp.DBInstances.DBInstance[0], nil
+}
+
+func (s *MongoDBService) WaitForMongoDBInstance(instanceId string, status Status, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	for {
+		instance, err := s.DescribeMongoDBInstance(instanceId)
+		if err != nil && !NotFoundError(err) {
+			return err
+		}
+
+		if instance.DBInstanceStatus == string(status) {
+			break
+		}
+
+		if timeout <= 0 {
+			return common.GetClientErrorFromString("Timeout")
+		}
+
+		timeout = timeout - DefaultIntervalMedium
+		time.Sleep(DefaultIntervalMedium * time.Second)
+	}
+	return nil
+}
+
+// DescribeMongoDBSecurityIps returns the IPs of the whitelist groups of the instance which are not hidden,
+// since the hidden groups are maintained by other products, like DTS.
+func (s *MongoDBService) DescribeMongoDBSecurityIps(instanceId string) (ips []string, err error) {
+	request := dds.CreateDescribeSecurityIpsRequest()
+	request.DBInstanceId = instanceId
+	raw, err := s.client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+		return ddsClient.DescribeSecurityIps(request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, _ := raw.(*dds.DescribeSecurityIpsResponse)
+
+	for _, group := range resp.SecurityIpGroups.SecurityIpGroup {
+		if group.SecurityIpGroupAttribute == "hidden" || group.SecurityIpList == "" {
+			continue
+		}
+		ips = append(ips, strings.Split(group.SecurityIpList, COMMA_SEPARATED)...)
+	}
+	return ips, nil
+}
+
+func (s *MongoDBService) ModifyMongoDBSecurityIps(instanceId string, ips []string) error {
+	request := dds.CreateModifySecurityIpsRequest()
+	request.DBInstanceId = instanceId
+	request.SecurityIpGroupName = "default"
+	request.ModifyMode = "Cover"
+	request.SecurityIps = strings.Join(ips, COMMA_SEPARATED)
+	_, err := s.client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+		return ddsClient.ModifySecurityIps(request)
+	})
+	return err
+}
+
+func (s *MongoDBService) DescribeMongoDBBackupPolicy(instanceId string) (policy *dds.DescribeBackupPolicyResponse, err error) {
+	request := dds.CreateDescribeBackupPolicyRequest()
+	request.DBInstanceId = instanceId
+	raw, err := s.client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+		return ddsClient.DescribeBackupPolicy(request)
+	})
+	if err != nil {
+		if IsExceptedError(err, InvalidMongoDBInstanceIdNotFound) {
+			return nil, GetNotFoundErrorFromString(GetNotFoundMessage("MongoDB Instance Policy", instanceId))
+		}
+		return nil, err
+	}
+	policy, _ = raw.(*dds.DescribeBackupPolicyResponse)
+
+	if policy == nil {
+		err = GetNotFoundErrorFromString(GetNotFoundMessage("MongoDB Instance Policy", instanceId))
+	}
+
+	return
+}
+
+func (s *MongoDBService) ModifyMongoDBBackupPolicy(instanceId string, period []string, time string) error {
+	request := dds.CreateModifyBackupPolicyRequest()
+	request.DBInstanceId = instanceId
+	request.PreferredBackupPeriod = strings.Join(period, COMMA_SEPARATED)
+	request.PreferredBackupTime = time
+	_, err := s.client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+		return ddsClient.ModifyBackupPolicy(request)
+	})
+	return err
+}
+
+func (s *MongoDBService) ResetMongoDBAccountPassword(instanceId, password string) error {
+	request := dds.CreateResetAccountPasswordRequest()
+	request.DBInstanceId = instanceId
+	request.AccountName = "root"
+	request.AccountPassword = password
+	_, err := s.client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+		return ddsClient.ResetAccountPassword(request)
+	})
+	return err
+}
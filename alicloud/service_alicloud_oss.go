@@ -1,6 +1,15 @@
 package alicloud
 
 import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
@@ -19,3 +28,310 @@ func (s *OssService) QueryOssBucketById(id string) (info *oss.BucketInfo, err er
 	bucket, _ := raw.(oss.GetBucketInfoResult)
 	return &bucket.BucketInfo, nil
 }
+
+func (s *OssService) DescribeResourceTags(resourceId string, resourceType TagResourceType) (map[string]string, error) {
+	raw, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return ossClient.GetBucketTagging(resourceId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	tagging, _ := raw.(oss.GetBucketTaggingResult)
+	tags := make(map[string]string)
+	for _, t := range tagging.Tags {
+		tags[t.Key] = t.Value
+	}
+	return tags, nil
+}
+
+// putBucketTags replaces all the tags of a bucket, or deletes them if there is none.
+func (s *OssService) putBucketTags(bucket string, tags map[string]string) error {
+	_, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		if len(tags) == 0 {
+			return nil, ossClient.DeleteBucketTagging(bucket)
+		}
+		var tagging oss.Tagging
+		for k, v := range tags {
+			tagging.Tags = append(tagging.Tags, oss.Tag{Key: k, Value: v})
+		}
+		return nil, ossClient.SetBucketTagging(bucket, tagging)
+	})
+	return err
+}
+
+// AddResourceTags puts the tags together with the existing ones, because OSS replaces all the tags of a bucket at once.
+func (s *OssService) AddResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	current, err := s.DescribeResourceTags(resourceId, resourceType)
+	if err != nil {
+		return err
+	}
+	for _, t := range tags {
+		current[t.Key] = t.Value
+	}
+	return s.putBucketTags(resourceId, current)
+}
+
+func (s *OssService) RemoveResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	current, err := s.DescribeResourceTags(resourceId, resourceType)
+	if err != nil {
+		return err
+	}
+	for _, t := range tags {
+		delete(current, t.Key)
+	}
+	return s.putBucketTags(resourceId, current)
+}
+
+// DescribeBucketVersioning returns the versioning status of the bucket, which is empty if versioning has never been enabled.
+func (s *OssService) DescribeBucketVersioning(bucket string) (string, error) {
+	raw, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return ossClient.GetBucketVersioning(bucket)
+	})
+	if err != nil {
+		return "", err
+	}
+	versioning, _ := raw.(oss.GetBucketVersioningResult)
+	return versioning.Status, nil
+}
+
+func (s *OssService) PutBucketVersioning(bucket string, status VersioningStatus) error {
+	_, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return nil, ossClient.SetBucketVersioning(bucket, oss.VersioningConfig{Status: string(status)})
+	})
+	return err
+}
+
+// DescribeBucketEncryption returns the default server-side encryption rule of the bucket, or nil if there is none.
+func (s *OssService) DescribeBucketEncryption(bucket string) (*oss.SSEDefaultRule, error) {
+	raw, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return ossClient.GetBucketEncryption(bucket)
+	})
+	if err != nil {
+		if IsExceptedError(err, NoSuchServerSideEncryptionRule) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	encryption, _ := raw.(oss.GetBucketEncryptionResult)
+	return &encryption.SSEDefault, nil
+}
+
+func (s *OssService) PutBucketEncryption(bucket string, algorithm SSEAlgorithm, kmsMasterKeyId string) error {
+	_, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return nil, ossClient.SetBucketEncryption(bucket, oss.ServerEncryptionRule{
+			SSEDefault: oss.SSEDefaultRule{
+				SSEAlgorithm:   string(algorithm),
+				KMSMasterKeyID: kmsMasterKeyId,
+			},
+		})
+	})
+	return err
+}
+
+func (s *OssService) DeleteBucketEncryption(bucket string) error {
+	_, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return nil, ossClient.DeleteBucketEncryption(bucket)
+	})
+	return err
+}
+
+// DescribeBucketPolicy returns the policy document of the bucket, or an empty string if there is none.
+func (s *OssService) DescribeBucketPolicy(bucket string) (string, error) {
+	raw, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return ossClient.GetBucketPolicy(bucket)
+	})
+	if err != nil {
+		if IsExceptedError(err, NoSuchBucketPolicy) {
+			return "", nil
+		}
+		return "", err
+	}
+	policy, _ := raw.(string)
+	return policy, nil
+}
+
+func (s *OssService) PutBucketPolicy(bucket, policy string) error {
+	_, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return nil, ossClient.SetBucketPolicy(bucket, policy)
+	})
+	return err
+}
+
+func (s *OssService) DeleteBucketPolicy(bucket string) error {
+	_, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return nil, ossClient.DeleteBucketPolicy(bucket)
+	})
+	return err
+}
+
+func (s *OssService) DescribeBucketTransferAcceleration(bucket string) (bool, error) {
+	raw, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return ossClient.GetBucketTransferAcc(bucket)
+	})
+	if err != nil {
+		if IsExceptedError(err, NoSuchTransferAccelerationConfiguration) {
+			return false, nil
+		}
+		return false, err
+	}
+	acceleration, _ := raw.(oss.TransferAccConfiguration)
+	return acceleration.Enabled, nil
+}
+
+func (s *OssService) PutBucketTransferAcceleration(bucket string, enabled bool) error {
+	_, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return nil, ossClient.SetBucketTransferAcc(bucket, oss.TransferAccConfiguration{Enabled: enabled})
+	})
+	return err
+}
+
+// ossBucketReplication is the XML document of the bucket replication APIs, which the OSS SDK sends and returns as it is.
+type ossBucketReplication struct {
+	XMLName xml.Name                   `xml:"ReplicationConfiguration"`
+	Rules   []ossBucketReplicationRule `xml:"Rule"`
+}
+
+type ossBucketReplicationRule struct {
+	ID                          string   `xml:"ID,omitempty"`
+	Prefixes                    []string `xml:"PrefixSet>Prefix,omitempty"`
+	Action                      string   `xml:"Action,omitempty"`
+	TargetBucket                string   `xml:"Destination>Bucket"`
+	TargetLocation              string   `xml:"Destination>Location"`
+	HistoricalObjectReplication string   `xml:"HistoricalObjectReplication,omitempty"`
+	Status                      string   `xml:"Status,omitempty"`
+}
+
+func (s *OssService) DescribeBucketReplication(bucket string) ([]ossBucketReplicationRule, error) {
+	raw, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return ossClient.GetBucketReplication(bucket)
+	})
+	if err != nil {
+		if IsExceptedError(err, NoSuchReplicationConfiguration) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var replication ossBucketReplication
+	if err := xml.Unmarshal([]byte(raw.(string)), &replication); err != nil {
+		return nil, fmt.Errorf("Unmarshalling the replication of bucket %s got an error: %#v", bucket, err)
+	}
+	return replication.Rules, nil
+}
+
+func (s *OssService) PutBucketReplication(bucket string, rule ossBucketReplicationRule) error {
+	body, err := xml.Marshal(ossBucketReplication{Rules: []ossBucketReplicationRule{rule}})
+	if err != nil {
+		return err
+	}
+	_, err = s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return nil, ossClient.PutBucketReplication(bucket, string(body))
+	})
+	return err
+}
+
+// DeleteBucketReplication stops the replication rule. The rule is being closed for a while after it is deleted.
+func (s *OssService) DeleteBucketReplication(bucket, ruleId string) error {
+	_, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return nil, ossClient.DeleteBucketReplication(bucket, ruleId)
+	})
+	if err != nil && !IsExceptedError(err, NoSuchReplicationConfiguration) {
+		return err
+	}
+	return nil
+}
+
+func (s *OssService) GetBucket(bucketName string) (*oss.Bucket, error) {
+	raw, err := s.client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return ossClient.Bucket(bucketName)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting bucket: %#v", err)
+	}
+	bucket, _ := raw.(*oss.Bucket)
+	return bucket, nil
+}
+
+// PutObjectFromFile uploads the file as the object. The file larger than OssMultipartThreshold is uploaded by
+// multipart upload, which does not support the Content-MD5 header, so contentMD5 is only sent with the simple upload.
+func (s *OssService) PutObjectFromFile(bucket *oss.Bucket, key, filePath, contentMD5 string, options ...oss.Option) error {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return err
+	}
+	if info.Size() > OssMultipartThreshold {
+		options = append(options, oss.Routines(OssMultipartRoutines))
+		return bucket.UploadFile(key, filePath, OssMultipartPartSize, options...)
+	}
+	if contentMD5 != "" {
+		options = append(options, oss.ContentMD5(contentMD5))
+	}
+	return bucket.PutObjectFromFile(key, filePath, options...)
+}
+
+// ListObjects returns the ETags of the objects with the prefix, keyed by the object keys.
+func (s *OssService) ListObjects(bucket *oss.Bucket, prefix string) (map[string]string, error) {
+	objects := make(map[string]string)
+	marker := ""
+	for {
+		result, err := bucket.ListObjects(oss.Prefix(prefix), oss.Marker(marker), oss.MaxKeys(OssMaxKeys))
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range result.Objects {
+			objects[object.Key] = object.ETag
+		}
+		if !result.IsTruncated {
+			break
+		}
+		marker = result.NextMarker
+	}
+	return objects, nil
+}
+
+// DeleteObjects deletes the objects in batches, since at most OssMaxKeys objects can be deleted in one request.
+func (s *OssService) DeleteObjects(bucket *oss.Bucket, keys []string) error {
+	for len(keys) > 0 {
+		size := len(keys)
+		if size > OssMaxKeys {
+			size = OssMaxKeys
+		}
+		if _, err := bucket.DeleteObjects(keys[:size], oss.DeleteObjectsQuiet(true)); err != nil {
+			return err
+		}
+		keys = keys[size:]
+	}
+	return nil
+}
+
+// ossFileContentMD5 returns the base64-encoded MD5 digest of the file, which is the value of the Content-MD5 header.
+func ossFileContentMD5(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+func ossContentMD5(content []byte) string {
+	sum := md5.Sum(content)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// ossETagMatches reports whether the ETag of an object is the MD5 of the content. The ETag of an object uploaded
+// by multipart upload is not the MD5 of its content, and it is always considered matched.
+func ossETagMatches(etag, contentMD5 string) bool {
+	etag = strings.Trim(etag, "\"")
+	if strings.Contains(etag, "-") {
+		return true
+	}
+	sum, err := base64.StdEncoding.DecodeString(contentMD5)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(etag, hex.EncodeToString(sum))
+}
@@ -181,3 +181,45 @@ func (s *OtsService) DescribeOtsInstanceTypes() (types []string, err error) {
 	}
 	return
 }
+
+func (s *OtsService) DescribeResourceTags(resourceId string, resourceType TagResourceType) (map[string]string, error) {
+	inst, err := s.DescribeOtsInstance(resourceId)
+	if err != nil {
+		return nil, err
+	}
+	return otsTagsToMap(inst.TagInfos.TagInfo), nil
+}
+
+func (s *OtsService) AddResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	args := ots.CreateInsertTagsRequest()
+	args.InstanceName = resourceId
+	var insertTags []ots.InsertTagsTagInfo
+	for _, t := range tags {
+		insertTags = append(insertTags, ots.InsertTagsTagInfo{
+			TagKey:   t.Key,
+			TagValue: t.Value,
+		})
+	}
+	args.TagInfo = &insertTags
+	_, err := s.client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
+		return otsClient.InsertTags(args)
+	})
+	return err
+}
+
+func (s *OtsService) RemoveResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	args := ots.CreateDeleteTagsRequest()
+	args.InstanceName = resourceId
+	var deleteTags []ots.DeleteTagsTagInfo
+	for _, t := range tags {
+		deleteTags = append(deleteTags, ots.DeleteTagsTagInfo{
+			TagKey:   t.Key,
+			TagValue: t.Value,
+		})
+	}
+	args.TagInfo = &deleteTags
+	_, err := s.client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
+		return otsClient.DeleteTags(args)
+	})
+	return err
+}
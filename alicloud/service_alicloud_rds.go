@@ -1,13 +1,16 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
@@ -392,146 +395,446 @@ func (s *RdsService) DescribeBackupPolicy(instanceId string) (policy *rds.Descri
 
 // WaitForInstance waits for instance to given status
 func (s *RdsService) WaitForDBInstance(instanceId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
+	waiter := &StateWaiter{
+		Product:      "RDS Instance",
+		Id:           instanceId,
+		Target:       []string{strings.ToLower(string(status))},
+		Timeout:      timeoutSeconds(timeout),
+		PollInterval: DefaultIntervalMedium * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			instance, err := s.DescribeDBInstanceById(instanceId)
+			if err != nil {
+				if NotFoundError(err) || IsExceptedError(err, InvalidDBInstanceIdNotFound) {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			if instance == nil {
+				return nil, "", nil
+			}
+			return instance, strings.ToLower(instance.DBInstanceStatus), nil
+		},
 	}
-	for {
-		instance, err := s.DescribeDBInstanceById(instanceId)
-		if err != nil && !NotFoundError(err) && !IsExceptedError(err, InvalidDBInstanceIdNotFound) {
-			return err
-		}
-		if instance != nil && strings.ToLower(instance.DBInstanceStatus) == strings.ToLower(string(status)) {
-			break
-		}
+	_, err := waiter.Wait()
+	return err
+}
 
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("RDS Instance", instanceId))
-		}
+func (s *RdsService) WaitForDBConnection(instanceId string, netType IPType, timeout int) error {
+	waiter := &StateWaiter{
+		Product:      "RDS Instance Connection",
+		Id:           instanceId,
+		Target:       []string{string(netType)},
+		Timeout:      timeoutSeconds(timeout),
+		PollInterval: DefaultIntervalMedium * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := s.DescribeDBInstanceNetInfoByIpType(instanceId, netType)
+			if err != nil {
+				if NotFoundError(err) {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+			if resp == nil {
+				return nil, "", nil
+			}
+			return resp, resp.IPType, nil
+		},
+	}
+	_, err := waiter.Wait()
+	return err
+}
 
-		timeout = timeout - DefaultIntervalMedium
-		time.Sleep(DefaultIntervalMedium * time.Second)
+func (s *RdsService) WaitForAccount(instanceId string, accountName string, status Status, timeout int) error {
+	waiter := &StateWaiter{
+		Product:      "RDS Account",
+		Id:           fmt.Sprintf("%s%s%s", instanceId, COLON_SEPARATED, accountName),
+		Target:       []string{string(status)},
+		Timeout:      timeoutSeconds(timeout),
+		PollInterval: DefaultIntervalMedium * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			account, err := s.DescribeDatabaseAccount(instanceId, accountName)
+			if err != nil {
+				return nil, "", err
+			}
+			if account == nil {
+				return nil, "", nil
+			}
+			return account, account.AccountStatus, nil
+		},
 	}
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
-func (s *RdsService) WaitForDBConnection(instanceId string, netType IPType, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
+func (s *RdsService) WaitForAccountPrivilege(instanceId, accountName, dbName, privilege string, timeout int) error {
+	waiter := &StateWaiter{
+		Product:      "RDS Account Privilege",
+		Id:           fmt.Sprintf("%s%s%s%s%s", instanceId, COLON_SEPARATED, accountName, COLON_SEPARATED, dbName),
+		Target:       []string{privilege},
+		Timeout:      timeoutSeconds(timeout),
+		PollInterval: DefaultIntervalMedium * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			account, err := s.DescribeDatabaseAccount(instanceId, accountName)
+			if err != nil {
+				return nil, "", err
+			}
+			if account == nil {
+				return nil, "", nil
+			}
+			for _, dp := range account.DatabasePrivileges.DatabasePrivilege {
+				if dp.DBName == dbName && dp.AccountPrivilege == privilege {
+					return account, privilege, nil
+				}
+			}
+			return account, "", nil
+		},
 	}
-	for {
-		resp, err := s.DescribeDBInstanceNetInfoByIpType(instanceId, netType)
-		if err != nil && !NotFoundError(err) {
-			return err
+	_, err := waiter.Wait()
+	return err
+}
+
+func (s *RdsService) WaitForAccountPrivilegeRevoked(instanceId, accountName, dbName string, timeout int) error {
+	waiter := &StateWaiter{
+		Product:      "RDS Account Privilege",
+		Id:           fmt.Sprintf("%s%s%s%s%s", instanceId, COLON_SEPARATED, accountName, COLON_SEPARATED, dbName),
+		Timeout:      timeoutSeconds(timeout),
+		PollInterval: DefaultIntervalMedium * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			account, err := s.DescribeDatabaseAccount(instanceId, accountName)
+			if err != nil {
+				return nil, "", err
+			}
+			if account != nil {
+				for _, dp := range account.DatabasePrivileges.DatabasePrivilege {
+					if dp.DBName == dbName {
+						return account, dp.AccountPrivilege, nil
+					}
+				}
+			}
+			return nil, "", nil
+		},
+	}
+	_, err := waiter.Wait()
+	return err
+}
+
+func (s *RdsService) DescribeParameters(instanceId string) (*rds.DescribeParametersResponse, error) {
+	request := rds.CreateDescribeParametersRequest()
+	request.DBInstanceId = instanceId
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeParameters(request)
+	})
+	if err != nil {
+		if IsExceptedErrors(err, []string{InvalidDBInstanceIdNotFound, InvalidDBInstanceNameNotFound}) {
+			return nil, GetNotFoundErrorFromString(GetNotFoundMessage("DB Instance", instanceId))
 		}
+		return nil, err
+	}
+	resp, _ := raw.(*rds.DescribeParametersResponse)
+	if resp == nil {
+		return nil, GetNotFoundErrorFromString(GetNotFoundMessage("DB Instance Parameters", instanceId))
+	}
+	return resp, nil
+}
 
-		if resp != nil && resp.IPType == string(netType) {
-			break
+// DescribeParameterTemplates returns the parameters which can be modified for the engine version, by their names.
+func (s *RdsService) DescribeParameterTemplates(engine, engineVersion string) (map[string]rds.TemplateRecord, error) {
+	request := rds.CreateDescribeParameterTemplatesRequest()
+	request.Engine = engine
+	request.EngineVersion = engineVersion
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeParameterTemplates(request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, _ := raw.(*rds.DescribeParameterTemplatesResponse)
+	templates := make(map[string]rds.TemplateRecord)
+	if resp != nil {
+		for _, template := range resp.Parameters.TemplateRecord {
+			templates[template.ParameterName] = template
 		}
+	}
+	return templates, nil
+}
 
-		if timeout <= 0 {
-			return common.GetClientErrorFromString("Timeout")
+// ModifyParameters applies the changed parameters of the attribute to the instance. The instance is only restarted
+// when one of the parameters requires it and forceRestart is true, otherwise an error is returned for these parameters.
+func (s *RdsService) ModifyParameters(d *schema.ResourceData, attribute string, forceRestart bool, timeout int) error {
+	o, n := d.GetChange(attribute)
+	oldParameters := make(map[string]string)
+	for _, raw := range o.(*schema.Set).List() {
+		parameter := raw.(map[string]interface{})
+		oldParameters[parameter["name"].(string)] = parameter["value"].(string)
+	}
+	changed := make(map[string]string)
+	for _, raw := range n.(*schema.Set).List() {
+		parameter := raw.(map[string]interface{})
+		name, value := parameter["name"].(string), parameter["value"].(string)
+		if old, ok := oldParameters[name]; !ok || old != value {
+			changed[name] = value
 		}
+	}
+	if len(changed) < 1 {
+		return nil
+	}
 
-		timeout = timeout - DefaultIntervalMedium
-		time.Sleep(DefaultIntervalMedium * time.Second)
+	instance, err := s.DescribeDBInstanceById(d.Id())
+	if err != nil {
+		return err
+	}
+	templates, err := s.DescribeParameterTemplates(instance.Engine, instance.EngineVersion)
+	if err != nil {
+		return fmt.Errorf("DescribeParameterTemplates got an error: %#v", err)
+	}
+	var restarting []string
+	for name := range changed {
+		template, ok := templates[name]
+		if !ok {
+			return fmt.Errorf("Parameter %s can not be modified on %s %s.", name, instance.Engine, instance.EngineVersion)
+		}
+		if strings.ToLower(template.ForceRestart) == "true" {
+			restarting = append(restarting, name)
+		}
+	}
+	if len(restarting) > 0 && !forceRestart {
+		return fmt.Errorf("Modifying the parameters %s restarts the DB instance %s. Set 'force_restart' to true to allow it.",
+			strings.Join(restarting, COMMA_SEPARATED), d.Id())
+	}
 
+	parameters, err := json.Marshal(changed)
+	if err != nil {
+		return err
 	}
-	return nil
-}
+	request := rds.CreateModifyParameterRequest()
+	request.DBInstanceId = d.Id()
+	request.Parameters = string(parameters)
+	request.Forcerestart = requests.NewBoolean(len(restarting) > 0)
 
-func (s *RdsService) WaitForAccount(instanceId string, accountName string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
+	if err := s.WaitForDBInstance(d.Id(), Running, timeout); err != nil {
+		return err
 	}
-	for {
+	_, err = s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.ModifyParameter(request)
+	})
+	if err != nil {
+		return fmt.Errorf("ModifyParameter got an error: %#v", err)
+	}
+	// The instance status changes after a while when the parameters are applied.
+	time.Sleep(DefaultIntervalShort * time.Second)
+	return s.WaitForDBInstance(d.Id(), Running, timeout)
+}
 
-		account, err := s.DescribeDatabaseAccount(instanceId, accountName)
-		if err != nil {
-			return err
-		}
+// RefreshParameters reads the values of the parameters which are set in the attribute. The other parameters
+// of the instance are left out, since there are hundreds of them.
+func (s *RdsService) RefreshParameters(d *schema.ResourceData, attribute string) error {
+	configured := d.Get(attribute).(*schema.Set).List()
+	if len(configured) < 1 {
+		return nil
+	}
 
-		if account != nil && account.AccountStatus == string(status) {
-			break
-		}
+	resp, err := s.DescribeParameters(d.Id())
+	if err != nil {
+		return fmt.Errorf("DescribeParameters got an error: %#v", err)
+	}
+	values := make(map[string]string)
+	for _, parameter := range resp.RunningParameters.DBInstanceParameter {
+		values[parameter.ParameterName] = parameter.ParameterValue
+	}
+	for _, parameter := range resp.ConfigParameters.DBInstanceParameter {
+		values[parameter.ParameterName] = parameter.ParameterValue
+	}
 
-		if timeout <= 0 {
-			return common.GetClientErrorFromString("Timeout")
+	var parameters []map[string]interface{}
+	for _, raw := range configured {
+		name := raw.(map[string]interface{})["name"].(string)
+		if value, ok := values[name]; ok {
+			parameters = append(parameters, map[string]interface{}{
+				"name":  name,
+				"value": value,
+			})
 		}
+	}
+	return d.Set(attribute, parameters)
+}
 
-		timeout = timeout - DefaultIntervalMedium
-		time.Sleep(DefaultIntervalMedium * time.Second)
+// rdsMySQLEngineVersionUpgrades are the MySQL versions which UpgradeDBInstanceEngineVersion can upgrade an instance
+// to, keyed by its current version. The API upgrades the engine by one major version at a time.
+var rdsMySQLEngineVersionUpgrades = map[string]string{
+	"5.5": "5.6",
+	"5.6": "5.7",
+	"5.7": "8.0",
+}
 
+// rdsEngineVersionUpgradable returns whether the engine of an instance can be upgraded from a version to another one
+// in place. Only the MySQL engine supports it, along the upgrade paths of rdsMySQLEngineVersionUpgrades.
+func rdsEngineVersionUpgradable(engine Engine, from, to string) bool {
+	if engine != MySQL {
+		return false
 	}
-	return nil
+	next, ok := rdsMySQLEngineVersionUpgrades[from]
+	return ok && next == to
 }
 
-func (s *RdsService) WaitForAccountPrivilege(instanceId, accountName, dbName, privilege string, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
+// UpgradeDBInstanceEngineVersion upgrades the engine of the instance immediately and waits for it running again.
+func (s *RdsService) UpgradeDBInstanceEngineVersion(instanceId, engineVersion string, timeout int) error {
+	if err := s.WaitForDBInstance(instanceId, Running, timeout); err != nil {
+		return err
+	}
+	request := rds.CreateUpgradeDBInstanceEngineVersionRequest()
+	request.DBInstanceId = instanceId
+	request.EngineVersion = engineVersion
+	request.EffectiveTime = "Immediate"
+	_, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.UpgradeDBInstanceEngineVersion(request)
+	})
+	if err != nil {
+		return fmt.Errorf("UpgradeDBInstanceEngineVersion got an error: %#v", err)
 	}
-	for {
 
-		account, err := s.DescribeDatabaseAccount(instanceId, accountName)
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		instance, err := s.DescribeDBInstanceById(instanceId)
 		if err != nil {
-			return err
+			return resource.NonRetryableError(err)
 		}
-
-		ready := false
-		if account != nil {
-			for _, dp := range account.DatabasePrivileges.DatabasePrivilege {
-				if dp.DBName == dbName && dp.AccountPrivilege == privilege {
-					ready = true
-					break
-				}
-			}
+		if instance.EngineVersion != engineVersion {
+			time.Sleep(DefaultIntervalMedium * time.Second)
+			return resource.RetryableError(fmt.Errorf("Waiting for the engine of DB instance %s upgraded to %s timeout.", instanceId, engineVersion))
 		}
-
-		if ready {
-			break
+		if err := s.WaitForDBInstance(instanceId, Running, timeout); err != nil {
+			return resource.NonRetryableError(err)
 		}
+		return nil
+	})
+}
 
-		if timeout <= 0 {
-			return common.GetClientErrorFromString("Timeout")
+func (s *RdsService) DescribeDBInstanceTDE(instanceId string) (string, error) {
+	request := rds.CreateDescribeDBInstanceTDERequest()
+	request.DBInstanceId = instanceId
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeDBInstanceTDE(request)
+	})
+	if err != nil {
+		if IsExceptedErrors(err, []string{InvalidDBInstanceIdNotFound, InvalidDBInstanceNameNotFound}) {
+			return "", GetNotFoundErrorFromString(GetNotFoundMessage("DB Instance", instanceId))
 		}
+		return "", err
+	}
+	resp, _ := raw.(*rds.DescribeDBInstanceTDEResponse)
+	if resp == nil {
+		return "", GetNotFoundErrorFromString(GetNotFoundMessage("DB Instance TDE", instanceId))
+	}
+	return resp.TDEStatus, nil
+}
 
-		timeout = timeout - DefaultIntervalMedium
-		time.Sleep(DefaultIntervalMedium * time.Second)
+// ModifyDBInstanceTDE enables the transparent data encryption of the instance. The data is encrypted by the service key
+// when the KMS key is not specified. TDE can not be disabled once it is enabled.
+func (s *RdsService) ModifyDBInstanceTDE(instanceId, encryptionKey string, timeout int) error {
+	request := rds.CreateModifyDBInstanceTDERequest()
+	request.DBInstanceId = instanceId
+	request.TDEStatus = string(RdsSecurityEnabled)
+	if encryptionKey != "" {
+		// EncryptionKey is not supported by the SDK yet
+		request.QueryParams["EncryptionKey"] = encryptionKey
+	}
 
+	if err := s.WaitForDBInstance(instanceId, Running, timeout); err != nil {
+		return err
 	}
-	return nil
+	_, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.ModifyDBInstanceTDE(request)
+	})
+	if err != nil {
+		return fmt.Errorf("ModifyDBInstanceTDE got an error: %#v", err)
+	}
+	// The instance restarts after a while when TDE is enabled.
+	time.Sleep(DefaultIntervalShort * time.Second)
+	return s.WaitForDBInstance(instanceId, Running, timeout)
 }
 
-func (s *RdsService) WaitForAccountPrivilegeRevoked(instanceId, accountName, dbName string, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-	for {
-		account, err := s.DescribeDatabaseAccount(instanceId, accountName)
-		if err != nil {
-			return err
-		}
+type rdsInstanceSSL struct {
+	ConnectionString string `json:"ConnectionString"`
+	SSLExpireTime    string `json:"SSLExpireTime"`
+	CAType           string `json:"CAType"`
+	ServerCert       string `json:"ServerCert"`
+}
 
-		exist := false
-		if account != nil {
-			for _, dp := range account.DatabasePrivileges.DatabasePrivilege {
-				if dp.DBName == dbName {
-					exist = true
-					break
-				}
-			}
+// DescribeDBInstanceSSL returns the SSL settings of the instance. The SSL is enabled when the connection string is not empty.
+func (s *RdsService) DescribeDBInstanceSSL(instanceId string) (*rdsInstanceSSL, error) {
+	request := rds.CreateDescribeDBInstanceSSLRequest()
+	request.DBInstanceId = instanceId
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeDBInstanceSSL(request)
+	})
+	if err != nil {
+		if IsExceptedErrors(err, []string{InvalidDBInstanceIdNotFound, InvalidDBInstanceNameNotFound}) {
+			return nil, GetNotFoundErrorFromString(GetNotFoundMessage("DB Instance", instanceId))
 		}
+		return nil, err
+	}
+	resp, _ := raw.(*rds.DescribeDBInstanceSSLResponse)
+	if resp == nil {
+		return nil, GetNotFoundErrorFromString(GetNotFoundMessage("DB Instance SSL", instanceId))
+	}
+	// The certificate fields are not supported by the SDK yet, so the response content is parsed again.
+	var ssl rdsInstanceSSL
+	if err := json.Unmarshal(resp.GetHttpContentBytes(), &ssl); err != nil {
+		return nil, fmt.Errorf("Unmarshalling the SSL settings got an error: %#v", err)
+	}
+	return &ssl, nil
+}
 
-		if !exist {
-			break
-		}
+func (s *RdsService) ModifyDBInstanceSSL(request *rds.ModifyDBInstanceSSLRequest, timeout int) error {
+	if err := s.WaitForDBInstance(request.DBInstanceId, Running, timeout); err != nil {
+		return err
+	}
+	_, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.ModifyDBInstanceSSL(request)
+	})
+	if err != nil {
+		return fmt.Errorf("ModifyDBInstanceSSL got an error: %#v", err)
+	}
+	// The instance restarts after a while when the SSL is modified.
+	time.Sleep(DefaultIntervalShort * time.Second)
+	return s.WaitForDBInstance(request.DBInstanceId, Running, timeout)
+}
 
-		if timeout <= 0 {
-			return common.GetClientErrorFromString("Timeout")
+func (s *RdsService) DescribeSQLCollectorPolicy(instanceId string) (*rds.DescribeSQLCollectorPolicyResponse, error) {
+	request := rds.CreateDescribeSQLCollectorPolicyRequest()
+	request.DBInstanceId = instanceId
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeSQLCollectorPolicy(request)
+	})
+	if err != nil {
+		if IsExceptedErrors(err, []string{InvalidDBInstanceIdNotFound, InvalidDBInstanceNameNotFound}) {
+			return nil, GetNotFoundErrorFromString(GetNotFoundMessage("DB Instance", instanceId))
 		}
+		return nil, err
+	}
+	resp, _ := raw.(*rds.DescribeSQLCollectorPolicyResponse)
+	if resp == nil {
+		return nil, GetNotFoundErrorFromString(GetNotFoundMessage("DB Instance SQL Collector Policy", instanceId))
+	}
+	return resp, nil
+}
 
-		timeout = timeout - DefaultIntervalMedium
-		time.Sleep(DefaultIntervalMedium * time.Second)
-
+// ModifySQLCollectorPolicy turns the SQL audit on or off. The retention is left unchanged when it is 0.
+func (s *RdsService) ModifySQLCollectorPolicy(instanceId string, status RdsSecurityStatus, retention int) error {
+	request := rds.CreateModifySQLCollectorPolicyRequest()
+	request.DBInstanceId = instanceId
+	// the API names the enabled status 'Enable'
+	request.SQLCollectorStatus = "Enable"
+	if status == RdsSecurityDisabled {
+		request.SQLCollectorStatus = string(RdsSecurityDisabled)
+	}
+	if retention > 0 {
+		request.StoragePeriod = requests.NewInteger(retention)
+	}
+	_, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.ModifySQLCollectorPolicy(request)
+	})
+	if err != nil {
+		return fmt.Errorf("ModifySQLCollectorPolicy got an error: %#v", err)
 	}
 	return nil
 }
@@ -585,3 +888,86 @@ func (s *RdsService) NotFoundDBInstance(err error) bool {
 
 	return false
 }
+
+func (s *RdsService) DescribeResourceTags(resourceId string, resourceType TagResourceType) (map[string]string, error) {
+	request := rds.CreateDescribeTagsRequest()
+	request.DBInstanceId = resourceId
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeTags(request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string)
+	resp, _ := raw.(*rds.DescribeTagsResponse)
+	if resp != nil {
+		for _, t := range resp.Items.TagInfos {
+			tags[t.TagKey] = t.TagValue
+		}
+	}
+	return tags, nil
+}
+
+// rdsTagsString returns the tags in the JSON format of the Tags parameter, e.g. {"key1":"value1"}.
+func rdsTagsString(tags []Tag) string {
+	m := make(map[string]string)
+	for _, t := range tags {
+		m[t.Key] = t.Value
+	}
+	b, _ := json.Marshal(m)
+	return string(b)
+}
+
+func (s *RdsService) AddResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	request := rds.CreateAddTagsToResourceRequest()
+	request.DBInstanceId = resourceId
+	request.Tags = rdsTagsString(tags)
+	_, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.AddTagsToResource(request)
+	})
+	return err
+}
+
+func (s *RdsService) RemoveResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	request := rds.CreateRemoveTagsFromResourceRequest()
+	request.DBInstanceId = resourceId
+	request.Tags = rdsTagsString(tags)
+	_, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.RemoveTagsFromResource(request)
+	})
+	return err
+}
+
+func (s *RdsService) DescribeResourcesByTags(resourceType TagResourceType, tags map[string]string) (ids []string, err error) {
+	req := rds.CreateDescribeDBInstancesRequest()
+	req.RegionId = s.client.RegionId
+	b, _ := json.Marshal(tags)
+	req.Tags = string(b)
+	req.PageSize = requests.NewInteger(PageSizeLarge)
+	req.PageNumber = requests.NewInteger(1)
+
+	for {
+		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return rdsClient.DescribeDBInstances(req)
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp, _ := raw.(*rds.DescribeDBInstancesResponse)
+		if resp == nil || len(resp.Items.DBInstance) < 1 {
+			break
+		}
+		for _, instance := range resp.Items.DBInstance {
+			ids = append(ids, instance.DBInstanceId)
+		}
+		if len(resp.Items.DBInstance) < PageSizeLarge {
+			break
+		}
+		if page, err := getNextpageNumber(req.PageNumber); err != nil {
+			return nil, err
+		} else {
+			req.PageNumber = page
+		}
+	}
+	return ids, nil
+}
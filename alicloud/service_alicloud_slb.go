@@ -5,12 +5,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strings"
-	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
-	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
@@ -133,54 +131,49 @@ func (s *SlbService) DescribeLoadBalancerListenerAttribute(loadBalancerId string
 }
 
 func (s *SlbService) WaitForLoadBalancer(loadBalancerId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		lb, err := s.DescribeLoadBalancerAttribute(loadBalancerId)
-
-		if err != nil {
-			if !NotFoundError(err) {
-
-				return err
+	waiter := &StateWaiter{
+		Product: "LoadBalancer",
+		Id:      loadBalancerId,
+		Target:  []string{strings.ToLower(string(status))},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			lb, err := s.DescribeLoadBalancerAttribute(loadBalancerId)
+			if err != nil {
+				if NotFoundError(err) {
+					return nil, "", nil
+				}
+				return nil, "", err
 			}
-		} else if &lb != nil && strings.ToLower(lb.LoadBalancerStatus) == strings.ToLower(string(status)) {
-			//TODO
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("LoadBalancer", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+			if lb == nil {
+				return nil, "", nil
+			}
+			return lb, strings.ToLower(lb.LoadBalancerStatus), nil
+		},
 	}
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *SlbService) WaitForListener(loadBalancerId string, port int, protocol Protocol, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		listener, err := s.DescribeLoadBalancerListenerAttribute(loadBalancerId, port, protocol)
-		if err != nil && !IsExceptedErrors(err, []string{LoadBalancerNotFound}) {
-			return err
-		}
-
-		if value, ok := listener["Status"]; ok && strings.ToLower(value.(string)) == strings.ToLower(string(status)) {
-			//TODO
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("LoadBalancer Listener", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
-
+	waiter := &StateWaiter{
+		Product: "LoadBalancer Listener",
+		Id:      fmt.Sprintf("%s%s%d", loadBalancerId, COLON_SEPARATED, port),
+		Target:  []string{strings.ToLower(string(status))},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			listener, err := s.DescribeLoadBalancerListenerAttribute(loadBalancerId, port, protocol)
+			if err != nil && !IsExceptedErrors(err, []string{LoadBalancerNotFound}) {
+				return nil, "", err
+			}
+			value, ok := listener["Status"]
+			if !ok {
+				return nil, "", nil
+			}
+			return listener, strings.ToLower(value.(string)), nil
+		},
 	}
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *SlbService) slbRemoveAccessControlListEntryPerTime(list []interface{}, aclId string) error {
@@ -350,33 +343,20 @@ func (s *SlbService) readFileContent(file_name string) (string, error) {
 	return string(b), err
 }
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func (s *SlbService) setSlbInstanceTags(d *schema.ResourceData) error {
-
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			if err := s.slbRemoveTags(remove, d.Id()); err != nil {
-				return err
-			}
-		}
-
-		if len(create) > 0 {
-			if err := s.slbAddTags(create, d.Id()); err != nil {
-				return err
-			}
-		}
-
-		d.SetPartial("tags")
+func (s *SlbService) DescribeResourceTags(resourceId string, resourceType TagResourceType) (map[string]string, error) {
+	tags, err := s.describeTags(resourceId)
+	if err != nil {
+		return nil, err
 	}
+	return s.slbTagsToMap(tags), nil
+}
 
-	return nil
+func (s *SlbService) AddResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	return s.slbAddTags(tags, resourceId)
+}
+
+func (s *SlbService) RemoveResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	return s.slbRemoveTags(tags, resourceId)
 }
 
 func toSlbTagsString(tags []Tag) string {
@@ -533,3 +513,40 @@ func (s *SlbService) slbTagsToMap(tags []Tag) map[string]string {
 
 	return result
 }
+
+func (s *SlbService) DescribeResourcesByTags(resourceType TagResourceType, tags map[string]string) (ids []string, err error) {
+	req := slb.CreateDescribeLoadBalancersRequest()
+	req.RegionId = s.client.RegionId
+	var slbTags []Tag
+	for k, v := range tags {
+		slbTags = append(slbTags, Tag{Key: k, Value: v})
+	}
+	req.Tags = toSlbTagsString(slbTags)
+	req.PageSize = requests.NewInteger(PageSizeLarge)
+	req.PageNumber = requests.NewInteger(1)
+
+	for {
+		raw, err := s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
+			return slbClient.DescribeLoadBalancers(req)
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp, _ := raw.(*slb.DescribeLoadBalancersResponse)
+		if resp == nil || len(resp.LoadBalancers.LoadBalancer) < 1 {
+			break
+		}
+		for _, lb := range resp.LoadBalancers.LoadBalancer {
+			ids = append(ids, lb.LoadBalancerId)
+		}
+		if len(resp.LoadBalancers.LoadBalancer) < PageSizeLarge {
+			break
+		}
+		if page, err := getNextpageNumber(req.PageNumber); err != nil {
+			return nil, err
+		} else {
+			req.PageNumber = page
+		}
+	}
+	return ids, nil
+}
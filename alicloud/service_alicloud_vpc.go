@@ -1,11 +1,12 @@
 package alicloud
 
 import (
-	"time"
-
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
@@ -271,125 +272,104 @@ func (s *VpcService) DescribeRouterInterface(regionId, interfaceId string) (ri v
 }
 
 func (s *VpcService) WaitForVpc(vpcId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		vpc, err := s.DescribeVpc(vpcId)
-		if err != nil {
-			return err
-		}
-		if vpc.Status == string(status) {
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("VPC", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+	waiter := &StateWaiter{
+		Product: "VPC",
+		Id:      vpcId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			vpc, err := s.DescribeVpc(vpcId)
+			if err != nil {
+				return nil, "", err
+			}
+			return vpc, vpc.Status, nil
+		},
 	}
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *VpcService) WaitForVSwitch(vswitchId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		vswitch, err := s.DescribeVswitch(vswitchId)
-		if err != nil {
-			return err
-		}
-		if vswitch.Status == string(status) {
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("VSwitch", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+	waiter := &StateWaiter{
+		Product: "VSwitch",
+		Id:      vswitchId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			vswitch, err := s.DescribeVswitch(vswitchId)
+			if err != nil {
+				return nil, "", err
+			}
+			return vswitch, vswitch.Status, nil
+		},
 	}
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *VpcService) WaitForAllRouteEntries(routeTableId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-	for {
-
-		table, err := s.QueryRouteTableById(routeTableId)
-
-		if err != nil {
-			return err
-		}
-
-		success := true
-
-		for _, routeEntry := range table.RouteEntrys.RouteEntry {
-			if routeEntry.Status != string(status) {
-				success = false
-				break
+	waiter := &StateWaiter{
+		Product: "All Route Entries",
+		Id:      routeTableId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			table, err := s.QueryRouteTableById(routeTableId)
+			if err != nil {
+				return nil, "", err
 			}
-		}
-		if success {
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("All Route Entries", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+			for _, routeEntry := range table.RouteEntrys.RouteEntry {
+				if routeEntry.Status != string(status) {
+					return table, routeEntry.Status, nil
+				}
+			}
+			return table, string(status), nil
+		},
 	}
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *VpcService) WaitForRouterInterface(regionId, interfaceId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-	for {
-		result, err := s.DescribeRouterInterface(regionId, interfaceId)
-		if err != nil {
-			if !NotFoundError(err) {
-				return err
+	waiter := &StateWaiter{
+		Product: "Router Interface",
+		Id:      interfaceId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			result, err := s.DescribeRouterInterface(regionId, interfaceId)
+			if err != nil {
+				if NotFoundError(err) {
+					return nil, "", nil
+				}
+				return nil, "", err
 			}
-		} else if result.Status == string(status) {
-			break
-		}
-
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("Router Interface", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+			return result, result.Status, nil
+		},
 	}
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *VpcService) WaitForEip(allocationId string, status Status, timeout int) error {
-	if timeout <= 0 {
-		timeout = DefaultTimeout
-	}
-
-	for {
-		eip, err := s.DescribeEipAddress(allocationId)
-		if err != nil {
-			if !NotFoundError(err) {
-				return err
+	waiter := &StateWaiter{
+		Product: "EIP",
+		Id:      allocationId,
+		Target:  []string{string(status)},
+		Timeout: timeoutSeconds(timeout),
+		Refresh: func() (interface{}, string, error) {
+			eip, err := s.DescribeEipAddress(allocationId)
+			if err != nil {
+				if NotFoundError(err) {
+					return nil, "", nil
+				}
+				return nil, "", err
 			}
-		} else if eip.Status == string(status) {
-			break
-		}
-		timeout = timeout - DefaultIntervalShort
-		if timeout <= 0 {
-			return GetTimeErrorFromString(GetTimeoutMessage("EIP", string(status)))
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+			return eip, eip.Status, nil
+		},
 	}
-	return nil
+	_, err := waiter.Wait()
+	return err
 }
 
 func (s *VpcService) DeactivateRouterInterface(interfaceId string) error {
@@ -415,3 +395,106 @@ func (s *VpcService) ActivateRouterInterface(interfaceId string) error {
 	}
 	return nil
 }
+
+func (s *VpcService) newTagRequest(action string) *requests.CommonRequest {
+	// Get product code from the built request
+	vpcReq := vpc.CreateDescribeVpcsRequest()
+	request := s.client.NewCommonRequest(vpcReq.GetProduct(), vpcReq.GetLocationServiceCode(), strings.ToUpper(string(Https)), connectivity.ApiVersion20160428)
+	request.ApiName = action
+	return request
+}
+
+func (s *VpcService) DescribeResourceTags(resourceId string, resourceType TagResourceType) (map[string]string, error) {
+	tags := make(map[string]string)
+	nextToken := ""
+	for {
+		request := s.newTagRequest("ListTagResources")
+		tagResourcesParams(request, resourceId, resourceType, nil)
+		if nextToken != "" {
+			request.QueryParams["NextToken"] = nextToken
+		}
+		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.ProcessCommonRequest(request)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if nextToken, err = parseListTagResources(raw, tags); err != nil {
+			return nil, err
+		}
+		if nextToken == "" {
+			return tags, nil
+		}
+	}
+}
+
+func (s *VpcService) AddResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	request := s.newTagRequest("TagResources")
+	tagResourcesParams(request, resourceId, resourceType, tags)
+	_, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.ProcessCommonRequest(request)
+	})
+	return err
+}
+
+func (s *VpcService) RemoveResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	request := s.newTagRequest("UntagResources")
+	tagResourcesParams(request, resourceId, resourceType, tags)
+	_, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.ProcessCommonRequest(request)
+	})
+	return err
+}
+
+// DescribeResourcesByTags returns the resources having all the tags. ListTagResources returns a resource once for
+// every tag it matches, so the resources are counted by the tags they match.
+func (s *VpcService) DescribeResourcesByTags(resourceType TagResourceType, tags map[string]string) (ids []string, err error) {
+	matches := make(map[string]int)
+	nextToken := ""
+	for {
+		request := s.newTagRequest("ListTagResources")
+		request.QueryParams["ResourceType"] = string(resourceType)
+		i := 1
+		for k, v := range tags {
+			request.QueryParams[fmt.Sprintf("Tag.%d.Key", i)] = k
+			request.QueryParams[fmt.Sprintf("Tag.%d.Value", i)] = v
+			i++
+		}
+		if nextToken != "" {
+			request.QueryParams["NextToken"] = nextToken
+		}
+		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.ProcessCommonRequest(request)
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp, _ := raw.(*responses.CommonResponse)
+		if resp == nil {
+			break
+		}
+		var result listTagResourcesResponse
+		if err := json.Unmarshal(resp.GetHttpContentBytes(), &result); err != nil {
+			return nil, fmt.Errorf("Unmarshalling the tagged resources got an error: %#v", err)
+		}
+		for _, r := range result.TagResources.TagResource {
+			if v, ok := tags[r.TagKey]; ok && v == r.TagValue {
+				if matches[r.ResourceId] == 0 {
+					ids = append(ids, r.ResourceId)
+				}
+				matches[r.ResourceId]++
+			}
+		}
+		if nextToken = result.NextToken; nextToken == "" {
+			break
+		}
+	}
+
+	var filtered []string
+	for _, id := range ids {
+		if matches[id] == len(tags) {
+			filtered = append(filtered, id)
+		}
+	}
+	return filtered, nil
+}
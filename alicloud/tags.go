@@ -1,10 +1,13 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
@@ -26,65 +29,177 @@ func tagsSchema() *schema.Schema {
 	}
 }
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTags(client *connectivity.AliyunClient, resourceType TagResourceType, d *schema.ResourceData) error {
+// tagsAllSchema returns the schema of tags_all, which are the tags of a resource including the default tags of the provider.
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+// mergeDefaultTags returns the default tags of the provider merged with the tags of a resource.
+// The tags of the resource win over the default tags with the same keys.
+func mergeDefaultTags(client *connectivity.AliyunClient, tags map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{})
+	for k, v := range client.DefaultTags {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			args := ecs.CreateRemoveTagsRequest()
-			args.ResourceType = string(resourceType)
-			args.ResourceId = d.Id()
-			var tags []ecs.RemoveTagsTag
+// customizeDiffTagsAll plans "tags_all" as the merged tags of the "tags" field and the provider default tags,
+// so that a resource is updated when only the default tags of the provider have been changed.
+func customizeDiffTagsAll(d *schema.ResourceDiff, meta interface{}) error {
+	// The tags having unknown values can not be read, and their count is not known then.
+	if !d.NewValueKnown("tags.%") {
+		return d.SetNewComputed("tags_all")
+	}
 
-			for _, t := range remove {
-				tags = append(tags, ecs.RemoveTagsTag{
-					Key:   t.Key,
-					Value: t.Value,
-				})
-			}
-			args.Tag = &tags
-			_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-				return ecsClient.RemoveTags(args)
-			})
-			if err != nil {
-				return fmt.Errorf("Remove tags got error: %s", err)
-			}
+	merged := mergeDefaultTags(meta.(*connectivity.AliyunClient), d.Get("tags").(map[string]interface{}))
+	old, _ := d.GetChange("tags_all")
+	current := old.(map[string]interface{})
+	changed := len(current) != len(merged)
+	for k, v := range merged {
+		if cv, ok := current[k]; !ok || cv != v {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return d.SetNew("tags_all", merged)
+}
+
+// diffTagsAll returns the tags which must be created and removed, so that the tags of a resource become
+// the merged tags of the "tags" field and the provider default tags. The current tags are expected to be
+// set to "tags_all" when the resource is read, and they are taken from the state because the planned
+// value of "tags_all" is already the merged one.
+func diffTagsAll(client *connectivity.AliyunClient, d *schema.ResourceData) ([]Tag, []Tag) {
+	old, _ := d.GetChange("tags_all")
+	current := old.(map[string]interface{})
+	create, remove := diffTags(tagsFromMap(current), tagsFromMap(mergeDefaultTags(client, d.Get("tags").(map[string]interface{}))))
+
+	// The tags which are already there are not created again.
+	var changed []Tag
+	for _, t := range create {
+		if v, ok := current[t.Key]; !ok || v != t.Value {
+			changed = append(changed, t)
 		}
+	}
+	return changed, remove
+}
 
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-			args := ecs.CreateAddTagsRequest()
-			args.ResourceType = string(resourceType)
-			args.ResourceId = d.Id()
-			var tags []ecs.AddTagsTag
-
-			for _, t := range create {
-				tags = append(tags, ecs.AddTagsTag{
-					Key:   t.Key,
-					Value: t.Value,
-				})
-			}
-			args.Tag = &tags
-			_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-				return ecsClient.AddTags(args)
-			})
-			if err != nil {
-				return fmt.Errorf("Creating tags got error: %s", err)
+// setTagsAll sets the tags read from a resource to "tags_all", and the ones out of the provider default tags
+// to "tags", so that the default tags do not show up as differences of the configuration. A default tag is
+// kept in "tags" when the resource sets it as well, or when its value has been changed out of Terraform.
+func setTagsAll(client *connectivity.AliyunClient, d *schema.ResourceData, tags map[string]string) {
+	configured := d.Get("tags").(map[string]interface{})
+	own := make(map[string]string)
+	for k, v := range tags {
+		if dv, ok := client.DefaultTags[k]; ok && dv == v {
+			if _, ok := configured[k]; !ok {
+				continue
 			}
 		}
+		own[k] = v
+	}
+	d.Set("tags", own)
+	d.Set("tags_all", tags)
+}
+
+// TagsService adds, removes and describes the tags of the resources of a product. The products having
+// only one taggable resource type ignore the resource type.
+type TagsService interface {
+	DescribeResourceTags(resourceId string, resourceType TagResourceType) (map[string]string, error)
+	AddResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error
+	RemoveResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error
+}
+
+// TaggedResourcesService finds the resources of a product having all the given tags.
+type TaggedResourcesService interface {
+	DescribeResourcesByTags(resourceType TagResourceType, tags map[string]string) ([]string, error)
+}
+
+// setTags is a helper to set the tags for a resource through the tagging API of its product. It expects the
+// tags field to be named "tags", and the provider default tags are merged into them.
+func setTags(client *connectivity.AliyunClient, service TagsService, resourceType TagResourceType, d *schema.ResourceData) error {
+
+	create, remove := diffTagsAll(client, d)
+
+	// Set tags
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
+		if err := service.RemoveResourceTags(d.Id(), resourceType, remove); err != nil {
+			return fmt.Errorf("Remove tags got error: %s", err)
+		}
+	}
+
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
+		if err := service.AddResourceTags(d.Id(), resourceType, create); err != nil {
+			return fmt.Errorf("Creating tags got error: %s", err)
+		}
 	}
 
 	return nil
 }
 
+// readTags reads the tags of a resource into "tags" and "tags_all".
+func readTags(client *connectivity.AliyunClient, service TagsService, resourceType TagResourceType, d *schema.ResourceData) error {
+	tags, err := service.DescribeResourceTags(d.Id(), resourceType)
+	if err != nil {
+		return fmt.Errorf("Describing the tags of %s got an error: %#v", d.Id(), err)
+	}
+	setTagsAll(client, d, tags)
+	return nil
+}
+
+// tagResourcesParams fills the parameters of the TagResources, UntagResources and ListTagResources APIs,
+// which are shared by the products tagging their resources through the resource manager, such as VPC and KVStore.
+func tagResourcesParams(request *requests.CommonRequest, resourceId string, resourceType TagResourceType, tags []Tag) {
+	request.QueryParams["ResourceType"] = string(resourceType)
+	request.QueryParams["ResourceId.1"] = resourceId
+	for i, t := range tags {
+		if request.ApiName == "UntagResources" {
+			request.QueryParams[fmt.Sprintf("TagKey.%d", i+1)] = t.Key
+			continue
+		}
+		request.QueryParams[fmt.Sprintf("Tag.%d.Key", i+1)] = t.Key
+		request.QueryParams[fmt.Sprintf("Tag.%d.Value", i+1)] = t.Value
+	}
+}
+
+// listTagResourcesResponse is the response of the ListTagResources API.
+type listTagResourcesResponse struct {
+	NextToken    string
+	TagResources struct {
+		TagResource []struct {
+			ResourceId string
+			TagKey     string
+			TagValue   string
+		}
+	}
+}
+
+// parseListTagResources returns the tags in a response of the ListTagResources API and the token of the next page.
+func parseListTagResources(raw interface{}, tags map[string]string) (string, error) {
+	resp, _ := raw.(*responses.CommonResponse)
+	if resp == nil {
+		return "", nil
+	}
+	var result listTagResourcesResponse
+	if err := json.Unmarshal(resp.GetHttpContentBytes(), &result); err != nil {
+		return "", fmt.Errorf("Unmarshalling the tags got an error: %#v", err)
+	}
+	for _, t := range result.TagResources.TagResource {
+		tags[t.TagKey] = t.TagValue
+	}
+	return result.NextToken, nil
+}
+
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
@@ -0,0 +1,227 @@
+package alicloud
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func testTagsResourceData(t *testing.T, tags map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"tags":     tagsSchema(),
+		"tags_all": tagsAllSchema(),
+	}, map[string]interface{}{"tags": tags})
+}
+
+func testTagsResource() *schema.Resource {
+	return &schema.Resource{
+		CustomizeDiff: customizeDiffTagsAll,
+		Schema: map[string]*schema.Schema{
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
+
+// testTagsResourceState returns the data of a resource whose state has the given tags_all, and whose tags are configured.
+func testTagsResourceState(tags map[string]interface{}, tagsAll map[string]string) *schema.ResourceData {
+	attributes := map[string]string{"tags_all.%": strconv.Itoa(len(tagsAll))}
+	for k, v := range tagsAll {
+		attributes["tags_all."+k] = v
+	}
+	d := testTagsResource().Data(&terraform.InstanceState{ID: "vpc-test", Attributes: attributes})
+	d.Set("tags", tags)
+	return d
+}
+
+func testTagKeys(tags []Tag) []string {
+	keys := make([]string, 0, len(tags))
+	for _, t := range tags {
+		keys = append(keys, t.Key+"="+t.Value)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	client := &connectivity.AliyunClient{DefaultTags: map[string]string{"Env": "test", "Owner": "ops"}}
+
+	merged := mergeDefaultTags(client, map[string]interface{}{"Env": "prod", "Name": "web"})
+	expected := map[string]interface{}{"Env": "prod", "Owner": "ops", "Name": "web"}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, merged)
+	}
+}
+
+func TestDiffTagsAll(t *testing.T) {
+	client := &connectivity.AliyunClient{DefaultTags: map[string]string{"Env": "test", "Owner": "ops"}}
+	d := testTagsResourceState(map[string]interface{}{"Name": "web", "Owner": "dev"}, map[string]string{"Env": "test", "Owner": "ops", "Stale": "yes"})
+
+	create, remove := diffTagsAll(client, d)
+	if keys := testTagKeys(create); !reflect.DeepEqual(keys, []string{"Name=web", "Owner=dev"}) {
+		t.Fatalf("unexpected tags to create: %v", keys)
+	}
+	if keys := testTagKeys(remove); !reflect.DeepEqual(keys, []string{"Owner=ops", "Stale=yes"}) {
+		t.Fatalf("unexpected tags to remove: %v", keys)
+	}
+}
+
+func TestCustomizeDiffTagsAll(t *testing.T) {
+	client := &connectivity.AliyunClient{DefaultTags: map[string]string{"Env": "test"}}
+	state := &terraform.InstanceState{ID: "vpc-test", Attributes: map[string]string{
+		"tags.%": "1", "tags.Name": "web", "tags_all.%": "2", "tags_all.Name": "web", "tags_all.Env": "test",
+	}}
+	raw, err := config.NewRawConfig(map[string]interface{}{"tags": map[string]interface{}{"Name": "web"}})
+	if err != nil {
+		t.Fatalf("creating the config got an error: %#v", err)
+	}
+
+	diff, err := testTagsResource().Diff(state, terraform.NewResourceConfig(raw), client)
+	if err != nil {
+		t.Fatalf("diffing the resource got an error: %#v", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("expected no difference, got %#v", diff.Attributes)
+	}
+
+	// Changing the default tags of the provider only changes tags_all.
+	client.DefaultTags = map[string]string{"Env": "prod"}
+	diff, err = testTagsResource().Diff(state, terraform.NewResourceConfig(raw), client)
+	if err != nil {
+		t.Fatalf("diffing the resource got an error: %#v", err)
+	}
+	if attr, ok := diff.Attributes["tags_all.Env"]; !ok || attr.Old != "test" || attr.New != "prod" {
+		t.Fatalf("expected tags_all.Env to be changed to prod, got %#v", diff.Attributes)
+	}
+	if _, ok := diff.Attributes["tags.Name"]; ok {
+		t.Fatalf("expected no difference of tags, got %#v", diff.Attributes)
+	}
+
+	// The tags_all are not known until the tags are known.
+	raw, err = config.NewRawConfig(map[string]interface{}{"tags": map[string]interface{}{"Name": config.UnknownVariableValue}})
+	if err != nil {
+		t.Fatalf("creating the config got an error: %#v", err)
+	}
+	diff, err = testTagsResource().Diff(state, terraform.NewResourceConfig(raw), client)
+	if err != nil {
+		t.Fatalf("diffing the resource got an error: %#v", err)
+	}
+	if attr, ok := diff.Attributes["tags_all.%"]; !ok || !attr.NewComputed {
+		t.Fatalf("expected tags_all to be computed, got %#v", diff.Attributes)
+	}
+}
+
+func TestSetTagsAll(t *testing.T) {
+	client := &connectivity.AliyunClient{DefaultTags: map[string]string{"Env": "test", "Owner": "ops", "Team": "infra"}}
+	d := testTagsResourceData(t, map[string]interface{}{"Name": "web", "Owner": "ops"})
+
+	setTagsAll(client, d, map[string]string{"Name": "web", "Env": "test", "Owner": "ops", "Team": "changed"})
+
+	// Env is only a default tag, Owner is set by the resource as well and Team has been changed out of Terraform.
+	expected := map[string]interface{}{"Name": "web", "Owner": "ops", "Team": "changed"}
+	if tags := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(tags, expected) {
+		t.Fatalf("expected tags %#v, got %#v", expected, tags)
+	}
+	if tagsAll := d.Get("tags_all").(map[string]interface{}); len(tagsAll) != 4 {
+		t.Fatalf("expected 4 tags in tags_all, got %#v", tagsAll)
+	}
+}
+
+type testTagsService struct {
+	tags map[string]string
+}
+
+func (s *testTagsService) DescribeResourceTags(resourceId string, resourceType TagResourceType) (map[string]string, error) {
+	return s.tags, nil
+}
+
+func (s *testTagsService) AddResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	for _, t := range tags {
+		s.tags[t.Key] = t.Value
+	}
+	return nil
+}
+
+func (s *testTagsService) RemoveResourceTags(resourceId string, resourceType TagResourceType, tags []Tag) error {
+	for _, t := range tags {
+		delete(s.tags, t.Key)
+	}
+	return nil
+}
+
+func TestSetTags(t *testing.T) {
+	client := &connectivity.AliyunClient{DefaultTags: map[string]string{"Env": "test"}}
+	service := &testTagsService{tags: map[string]string{"Env": "test", "Stale": "yes"}}
+	d := testTagsResourceState(map[string]interface{}{"Name": "web"}, service.tags)
+
+	if err := setTags(client, service, TagResourceVpc, d); err != nil {
+		t.Fatalf("setting the tags got an error: %#v", err)
+	}
+	expected := map[string]string{"Env": "test", "Name": "web"}
+	if !reflect.DeepEqual(service.tags, expected) {
+		t.Fatalf("expected the tags %#v, got %#v", expected, service.tags)
+	}
+
+	d = testTagsResourceData(t, map[string]interface{}{"Name": "web"})
+	d.SetId("vpc-test")
+	if err := readTags(client, service, TagResourceVpc, d); err != nil {
+		t.Fatalf("reading the tags got an error: %#v", err)
+	}
+	if tags := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(tags, map[string]interface{}{"Name": "web"}) {
+		t.Fatalf("expected the default tags to be left out of the tags, got %#v", tags)
+	}
+}
+
+func TestTagResourcesParams(t *testing.T) {
+	request := requests.NewCommonRequest()
+	request.ApiName = "TagResources"
+	tagResourcesParams(request, "vpc-test", TagResourceVpc, []Tag{{Key: "Name", Value: "web"}})
+	expected := map[string]string{"ResourceType": "VPC", "ResourceId.1": "vpc-test", "Tag.1.Key": "Name", "Tag.1.Value": "web"}
+	if !reflect.DeepEqual(request.QueryParams, expected) {
+		t.Fatalf("expected the parameters %#v, got %#v", expected, request.QueryParams)
+	}
+
+	request = requests.NewCommonRequest()
+	request.ApiName = "UntagResources"
+	tagResourcesParams(request, "vpc-test", TagResourceVpc, []Tag{{Key: "Name", Value: "web"}})
+	expected = map[string]string{"ResourceType": "VPC", "ResourceId.1": "vpc-test", "TagKey.1": "Name"}
+	if !reflect.DeepEqual(request.QueryParams, expected) {
+		t.Fatalf("expected the parameters %#v, got %#v", expected, request.QueryParams)
+	}
+}
+
+func testHttpResponse(body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestParseListTagResources(t *testing.T) {
+	resp := responses.NewCommonResponse()
+	if err := responses.Unmarshal(resp, testHttpResponse(`{"NextToken":"next","TagResources":{"TagResource":[`+
+		`{"ResourceId":"vpc-test","TagKey":"Name","TagValue":"web"},{"ResourceId":"vpc-test","TagKey":"Env","TagValue":"test"}]}}`), "JSON"); err != nil {
+		t.Fatalf("unmarshalling the response got an error: %#v", err)
+	}
+
+	tags := make(map[string]string)
+	nextToken, err := parseListTagResources(resp, tags)
+	if err != nil {
+		t.Fatalf("parsing the response got an error: %#v", err)
+	}
+	if nextToken != "next" || !reflect.DeepEqual(tags, map[string]string{"Name": "web", "Env": "test"}) {
+		t.Fatalf("unexpected tags %#v and next token %s", tags, nextToken)
+	}
+}
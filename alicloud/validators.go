@@ -1435,3 +1435,19 @@ func validateDataSourceSlbsTagsNum(v interface{}, k string) (ws []string, errors
 	}
 	return
 }
+
+// validateFCTimerCronExpression checks the cron expression of the function compute timer trigger, which is
+// either "@every <duration>" or a cron expression with six fields starting with the seconds.
+func validateFCTimerCronExpression(v interface{}, k string) (ws []string, errors []error) {
+	value := strings.TrimSpace(v.(string))
+	if strings.HasPrefix(value, "@every ") {
+		if _, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(value, "@every "))); err != nil {
+			errors = append(errors, fmt.Errorf("%q must contain a valid duration after @every, got %q: %s", k, value, err))
+		}
+		return
+	}
+	if fields := strings.Fields(value); len(fields) != 6 {
+		errors = append(errors, fmt.Errorf("%q must be either @every <duration> or a cron expression with 6 fields, got %q", k, value))
+	}
+	return
+}
@@ -464,3 +464,21 @@ func TestValidateIntegerInRange(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateFCTimerCronExpression(t *testing.T) {
+	validExpressions := []string{"@every 1m", "@every 1h30m", "0 0 4 * * *", "0 */5 * * * *"}
+	for _, v := range validExpressions {
+		_, errors := validateFCTimerCronExpression(v, "cron_expression")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid cron expression: %q", v, errors)
+		}
+	}
+
+	invalidExpressions := []string{"", "@every", "@every 1x", "0 4 * * *", "every 1m"}
+	for _, v := range invalidExpressions {
+		_, errors := validateFCTimerCronExpression(v, "cron_expression")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid cron expression", v)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// StateWaiter polls a resource by its Refresh function until it reaches one of the Target states.
+// It is a thin wrapper of resource.StateChangeConf, which makes the WaitFor* methods of the services
+// share the same polling, the same timeout handling and the same timeout error message.
+//
+// The Refresh function returns a nil result without error when the resource is not found and it is
+// expected to appear later. When Target is empty, the waiter waits for the resource to be absent.
+type StateWaiter struct {
+	Product string
+	Id      string
+	Pending []string
+	Target  []string
+	Refresh resource.StateRefreshFunc
+
+	// Timeout is DefaultTimeout seconds if it is not set.
+	Timeout time.Duration
+	// PollInterval is DefaultIntervalShort seconds if it is not set.
+	PollInterval time.Duration
+	// Delay is the time to wait before the first refresh.
+	Delay time.Duration
+}
+
+// Wait waits for the target states with the timeout of the waiter.
+func (w *StateWaiter) Wait() (interface{}, error) {
+	return w.WaitWithContext(context.Background())
+}
+
+// WaitWithContext waits for the target states until the timeout of the waiter or the deadline of the context,
+// whichever comes first. It stops refreshing once the context is cancelled.
+func (w *StateWaiter) WaitWithContext(ctx context.Context) (interface{}, error) {
+	timeout := w.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout * time.Second
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < timeout {
+			timeout = remaining
+		}
+	}
+	pollInterval := w.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultIntervalShort * time.Second
+	}
+
+	conf := &resource.StateChangeConf{
+		Pending: w.Pending,
+		Target:  w.Target,
+		Refresh: func() (interface{}, string, error) {
+			if err := ctx.Err(); err != nil {
+				return nil, "", fmt.Errorf("Waiting for %s %s got an error: %#v", w.Product, w.Id, err)
+			}
+			return w.Refresh()
+		},
+		Timeout:      timeout,
+		Delay:        w.Delay,
+		PollInterval: pollInterval,
+		// Whether a resource which is not found is expected is decided by the Refresh function.
+		NotFoundChecks: math.MaxInt32,
+	}
+
+	raw, err := conf.WaitForState()
+	if err != nil {
+		if _, ok := err.(*resource.TimeoutError); ok {
+			return raw, GetTimeErrorFromString(GetWaitTimeoutMessage(w.Product, w.Id, w.Target, timeout))
+		}
+		return raw, err
+	}
+	return raw, nil
+}
+
+// timeoutSeconds converts the timeout in seconds of the WaitFor* methods to a duration.
+func timeoutSeconds(timeout int) time.Duration {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return time.Duration(timeout) * time.Second
+}
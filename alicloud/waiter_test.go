@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testStateRefresh returns a fake refresh function which returns the states in turn, and keeps returning the last one.
+// An empty state means the resource is not found.
+func testStateRefresh(states ...string) (func() (interface{}, string, error), *int) {
+	calls := 0
+	return func() (interface{}, string, error) {
+		state := states[len(states)-1]
+		if calls < len(states) {
+			state = states[calls]
+		}
+		calls++
+		if state == "" {
+			return nil, "", nil
+		}
+		return state, state, nil
+	}, &calls
+}
+
+func testStateWaiter(refresh func() (interface{}, string, error), target ...string) *StateWaiter {
+	return &StateWaiter{
+		Product:      "Test Resource",
+		Id:           "test-id",
+		Target:       target,
+		Refresh:      refresh,
+		Timeout:      time.Second,
+		PollInterval: 10 * time.Millisecond,
+	}
+}
+
+func TestStateWaiter_target(t *testing.T) {
+	refresh, calls := testStateRefresh("", "Pending", "Pending", "Running")
+	raw, err := testStateWaiter(refresh, "Running").Wait()
+	if err != nil {
+		t.Fatalf("waiting got an error: %#v", err)
+	}
+	if raw != "Running" || *calls != 4 {
+		t.Fatalf("expected the Running result after 4 refreshes, got %v after %d", raw, *calls)
+	}
+}
+
+func TestStateWaiter_absent(t *testing.T) {
+	refresh, calls := testStateRefresh("Deleting", "Deleting", "")
+	if _, err := testStateWaiter(refresh).Wait(); err != nil {
+		t.Fatalf("waiting got an error: %#v", err)
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 refreshes, got %d", *calls)
+	}
+}
+
+func TestStateWaiter_timeout(t *testing.T) {
+	refresh, _ := testStateRefresh("Pending")
+	waiter := testStateWaiter(refresh, "Running")
+	waiter.Timeout = 100 * time.Millisecond
+
+	_, err := waiter.Wait()
+	if err == nil || !IsExceptedError(err, WaitForTimeout) {
+		t.Fatalf("expected a WaitForTimeout error, got %#v", err)
+	}
+	if expected := GetWaitTimeoutMessage("Test Resource", "test-id", []string{"Running"}, 100*time.Millisecond); !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected the error message %q, got %q", expected, err.Error())
+	}
+}
+
+func TestStateWaiter_refreshError(t *testing.T) {
+	calls := 0
+	waiter := testStateWaiter(func() (interface{}, string, error) {
+		calls++
+		return nil, "", fmt.Errorf("InternalError")
+	}, "Running")
+
+	if _, err := waiter.Wait(); err == nil || err.Error() != "InternalError" {
+		t.Fatalf("expected the error of the refresh function, got %#v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected to stop after the first error, got %d refreshes", calls)
+	}
+}
+
+func TestStateWaiter_unexpectedState(t *testing.T) {
+	refresh, _ := testStateRefresh("Pending", "Error")
+	waiter := testStateWaiter(refresh, "Running")
+	waiter.Pending = []string{"Pending"}
+
+	if _, err := waiter.Wait(); err == nil || !strings.Contains(err.Error(), "Error") {
+		t.Fatalf("expected an unexpected state error, got %#v", err)
+	}
+}
+
+func TestStateWaiter_context(t *testing.T) {
+	refresh, calls := testStateRefresh("Pending")
+	waiter := testStateWaiter(refresh, "Running")
+	waiter.Timeout = time.Minute
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	if _, err := waiter.WaitWithContext(ctx); err == nil || !strings.Contains(err.Error(), "context canceled") {
+		t.Fatalf("expected a cancelled error, got %#v", err)
+	}
+	if time.Since(start) > 10*time.Second || *calls == 0 {
+		t.Fatalf("expected the waiter to stop once the context is cancelled, got %d refreshes in %s", *calls, time.Since(start))
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := waiter.WaitWithContext(ctx); err == nil || !IsExceptedError(err, WaitForTimeout) {
+		t.Fatalf("expected the deadline of the context to time out the waiter, got %#v", err)
+	}
+}